@@ -0,0 +1,289 @@
+package slack
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// AdminAnalyticsType is the kind of analytics data returned by admin.analytics.getFile.
+type AdminAnalyticsType string
+
+const (
+	AdminAnalyticsTypeMember        AdminAnalyticsType = "member"
+	AdminAnalyticsTypePublicChannel AdminAnalyticsType = "public_channel"
+)
+
+// MemberAnalytics is a single member record of an analytics file.
+type MemberAnalytics struct {
+	EnterpriseID               string `json:"enterprise_id"`
+	TeamID                     string `json:"team_id"`
+	Date                       string `json:"date"`
+	UserID                     string `json:"user_id"`
+	EmailAddress               string `json:"email_address"`
+	IsGuest                    bool   `json:"is_guest"`
+	IsBillableSeat             bool   `json:"is_billable_seat"`
+	IsActive                   bool   `json:"is_active"`
+	IsActiveIOS                bool   `json:"is_active_ios"`
+	IsActiveAndroid            bool   `json:"is_active_android"`
+	IsActiveDesktop            bool   `json:"is_active_desktop"`
+	IsActiveApps               bool   `json:"is_active_apps"`
+	IsActiveWorkflows          bool   `json:"is_active_workflows"`
+	IsActiveSlackConnect       bool   `json:"is_active_slack_connect"`
+	ReactionsAddedCount        int    `json:"reactions_added_count"`
+	MessagesPostedCount        int    `json:"messages_posted_count"`
+	ChannelMessagesPostedCount int    `json:"channel_messages_posted_count"`
+	FilesAddedCount            int    `json:"files_added_count"`
+	TotalCallsCount            int    `json:"total_calls_count"`
+	SlackCallsCount            int    `json:"slack_calls_count"`
+	SlackHuddlesCount          int    `json:"slack_huddles_count"`
+	SearchCount                int    `json:"search_count"`
+	DateClaimed                int64  `json:"date_claimed"`
+}
+
+// AdminAnalyticsTeam identifies a workspace in an analytics record.
+type AdminAnalyticsTeam struct {
+	TeamID string `json:"team_id"`
+	Name   string `json:"name"`
+}
+
+// AdminAnalyticsOrganization identifies an external organization a channel is shared with.
+type AdminAnalyticsOrganization struct {
+	Name   string `json:"name"`
+	Domain string `json:"domain"`
+}
+
+// PublicChannelAnalytics is a single public channel record of an analytics file.
+type PublicChannelAnalytics struct {
+	EnterpriseID                      string                       `json:"enterprise_id"`
+	OriginatingTeam                   AdminAnalyticsTeam           `json:"originating_team"`
+	ChannelID                         string                       `json:"channel_id"`
+	Date                              string                       `json:"date"`
+	DateCreated                       int64                        `json:"date_created"`
+	DateLastActive                    int64                        `json:"date_last_active"`
+	TotalMembersCount                 int                          `json:"total_members_count"`
+	FullMembersCount                  int                          `json:"full_members_count"`
+	GuestMemberCount                  int                          `json:"guest_member_count"`
+	MessagesPostedCount               int                          `json:"messages_posted_count"`
+	MessagesPostedByMembersCount      int                          `json:"messages_posted_by_members_count"`
+	MembersWhoViewedCount             int                          `json:"members_who_viewed_count"`
+	MembersWhoPostedCount             int                          `json:"members_who_posted_count"`
+	ReactionsAddedCount               int                          `json:"reactions_added_count"`
+	Visibility                        string                       `json:"visibility"`
+	ChannelType                       string                       `json:"channel_type"`
+	IsSharedExternally                bool                         `json:"is_shared_externally"`
+	SharedWith                        []AdminAnalyticsTeam         `json:"shared_with"`
+	ExternallySharedWithOrganizations []AdminAnalyticsOrganization `json:"externally_shared_with_organizations"`
+}
+
+// PublicChannelMetadata is a single record of a public channel metadata file.
+type PublicChannelMetadata struct {
+	ChannelID   string `json:"channel_id"`
+	Name        string `json:"name"`
+	Topic       string `json:"topic"`
+	Description string `json:"description"`
+	Date        string `json:"date"`
+}
+
+// AdminAnalyticsGetFileParams contains arguments for AdminAnalyticsGetFile method call.
+type AdminAnalyticsGetFileParams struct {
+	Type AdminAnalyticsType
+	// Date in YYYY-MM-DD format. Not used when MetadataOnly is set.
+	Date string
+	// MetadataOnly retrieves channel metadata instead of analytics. Only
+	// valid with AdminAnalyticsTypePublicChannel.
+	MetadataOnly bool
+}
+
+// AdminAnalyticsFile streams the decompressed contents of an analytics file.
+// Records are newline-delimited JSON objects. The file must be closed once
+// read.
+type AdminAnalyticsFile struct {
+	body io.ReadCloser
+	gz   *gzip.Reader
+}
+
+// Read implements io.Reader, returning the decompressed file contents.
+func (f *AdminAnalyticsFile) Read(p []byte) (int, error) {
+	return f.gz.Read(p)
+}
+
+// Close releases the underlying HTTP response.
+func (f *AdminAnalyticsFile) Close() error {
+	gzErr := f.gz.Close()
+	if err := f.body.Close(); err != nil {
+		return err
+	}
+	return gzErr
+}
+
+// MemberAnalytics iterates over the records of a member analytics file.
+func (f *AdminAnalyticsFile) MemberAnalytics() iter.Seq2[MemberAnalytics, error] {
+	return analyticsRecords[MemberAnalytics](f)
+}
+
+// PublicChannelAnalytics iterates over the records of a public channel analytics file.
+func (f *AdminAnalyticsFile) PublicChannelAnalytics() iter.Seq2[PublicChannelAnalytics, error] {
+	return analyticsRecords[PublicChannelAnalytics](f)
+}
+
+// PublicChannelMetadata iterates over the records of a public channel metadata file.
+func (f *AdminAnalyticsFile) PublicChannelMetadata() iter.Seq2[PublicChannelMetadata, error] {
+	return analyticsRecords[PublicChannelMetadata](f)
+}
+
+// analyticsRecords decodes one record at a time, so the file is never held
+// in memory. Iteration stops after the first error.
+func analyticsRecords[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		dec := json.NewDecoder(r)
+		for {
+			var record T
+			err := dec.Decode(&record)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if !yield(record, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// AdminAnalyticsGetFile retrieves an analytics file. The response is
+// decompressed as it is read rather than buffered, so callers must close
+// the returned file.
+// For more information see the admin.analytics.getFile docs:
+// https://api.slack.com/methods/admin.analytics.getFile
+func (api *Client) AdminAnalyticsGetFile(ctx context.Context, params AdminAnalyticsGetFileParams) (*AdminAnalyticsFile, error) {
+	const method = "admin.analytics.getFile"
+	values := url.Values{
+		"token": {api.token},
+		"type":  {string(params.Type)},
+	}
+
+	if params.Date != "" {
+		values.Add("date", params.Date)
+	}
+
+	if params.MetadataOnly {
+		values.Add("metadata_only", "true")
+	}
+
+	var file *AdminAnalyticsFile
+	response := &SlackResponse{}
+	err := api.doWithToken(ctx, method, values, response, func(ctx context.Context, token string) (http.Header, error) {
+		f, headers, err := api.getAnalyticsFile(ctx, valuesWithToken(values, token), response)
+		file = f
+		return headers, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		if err := response.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("admin.analytics.getFile: response is not a gzip file")
+	}
+	return file, nil
+}
+
+// getAnalyticsFile requests an analytics file. When Slack answers with JSON
+// rather than a gzip file, the JSON is decoded into response and no file is
+// returned.
+func (api *Client) getAnalyticsFile(ctx context.Context, values url.Values, response *SlackResponse) (*AdminAnalyticsFile, http.Header, error) {
+	body := values.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, api.endpoint+"admin.analytics.getFile", strings.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// allow retry client to re-send the request body on 429/5xx.
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(body)), nil
+	}
+
+	resp, err := api.httpclient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = checkStatusCode(resp, api); err != nil {
+		resp.Body.Close()
+		return nil, resp.Header, err
+	}
+
+	// Errors are returned as regular JSON responses rather than a gzip file.
+	br := bufio.NewReader(resp.Body)
+	if magic, _ := br.Peek(2); len(magic) < 2 || magic[0] != 0x1f || magic[1] != 0x8b {
+		defer resp.Body.Close()
+		if err := json.NewDecoder(br).Decode(response); err != nil {
+			return nil, resp.Header, err
+		}
+		setCallInfo(response, resp)
+		return nil, resp.Header, nil
+	}
+
+	gz, err := gzip.NewReader(br)
+	if err != nil {
+		resp.Body.Close()
+		return nil, resp.Header, err
+	}
+
+	return &AdminAnalyticsFile{body: resp.Body, gz: gz}, resp.Header, nil
+}
+
+// adminAnalyticsRecords opens an analytics file and closes it once
+// iteration is done.
+func adminAnalyticsRecords[T any](ctx context.Context, api *Client, params AdminAnalyticsGetFileParams) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		f, err := api.AdminAnalyticsGetFile(ctx, params)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		defer f.Close()
+
+		for record, err := range analyticsRecords[T](f) {
+			if !yield(record, err) {
+				return
+			}
+		}
+	}
+}
+
+// AdminAnalyticsMembers streams the member analytics for the given date
+// (YYYY-MM-DD). Iteration stops at the first error.
+func (api *Client) AdminAnalyticsMembers(ctx context.Context, date string) iter.Seq2[MemberAnalytics, error] {
+	return adminAnalyticsRecords[MemberAnalytics](ctx, api, AdminAnalyticsGetFileParams{
+		Type: AdminAnalyticsTypeMember,
+		Date: date,
+	})
+}
+
+// AdminAnalyticsPublicChannels streams the public channel analytics for the
+// given date (YYYY-MM-DD). Iteration stops at the first error.
+func (api *Client) AdminAnalyticsPublicChannels(ctx context.Context, date string) iter.Seq2[PublicChannelAnalytics, error] {
+	return adminAnalyticsRecords[PublicChannelAnalytics](ctx, api, AdminAnalyticsGetFileParams{
+		Type: AdminAnalyticsTypePublicChannel,
+		Date: date,
+	})
+}
+
+// AdminAnalyticsPublicChannelMetadata streams the metadata of public
+// channels, such as names and topics. Iteration stops at the first error.
+func (api *Client) AdminAnalyticsPublicChannelMetadata(ctx context.Context) iter.Seq2[PublicChannelMetadata, error] {
+	return adminAnalyticsRecords[PublicChannelMetadata](ctx, api, AdminAnalyticsGetFileParams{
+		Type:         AdminAnalyticsTypePublicChannel,
+		MetadataOnly: true,
+	})
+}
@@ -0,0 +1,181 @@
+package slack
+
+import (
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockAdminAnalyticsHandler(t *testing.T, wantType string, lines ...string) func(rw http.ResponseWriter, r *http.Request) {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, wantType, r.FormValue("type"))
+
+		rw.Header().Set("Content-Type", "application/gzip")
+		gz := gzip.NewWriter(rw)
+		for _, line := range lines {
+			gz.Write([]byte(line + "\n"))
+		}
+		gz.Close()
+	}
+}
+
+func TestAdminAnalyticsMembers(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.analytics.getFile", mockAdminAnalyticsHandler(t, "member",
+		`{"enterprise_id":"E1","team_id":"T1","date":"2026-10-01","user_id":"W1","email_address":"a@example.com","is_active":true,"messages_posted_count":12}`,
+		`{"enterprise_id":"E1","team_id":"T1","date":"2026-10-01","user_id":"W2","is_guest":true,"search_count":3}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	var records []MemberAnalytics
+	for record, err := range api.AdminAnalyticsMembers(context.Background(), "2026-10-01") {
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+
+	require.Len(t, records, 2)
+	assert.Equal(t, "W1", records[0].UserID)
+	assert.True(t, records[0].IsActive)
+	assert.Equal(t, 12, records[0].MessagesPostedCount)
+	assert.True(t, records[1].IsGuest)
+	assert.Equal(t, 3, records[1].SearchCount)
+}
+
+func TestAdminAnalyticsGetFilePublicChannels(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.analytics.getFile", mockAdminAnalyticsHandler(t, "public_channel",
+		`{"enterprise_id":"E1","originating_team":{"team_id":"T1","name":"Acme"},"channel_id":"C1","date":"2026-10-01","total_members_count":40,"is_shared_externally":true,"externally_shared_with_organizations":[{"name":"Other","domain":"other"}]}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	f, err := api.AdminAnalyticsGetFile(context.Background(), AdminAnalyticsGetFileParams{
+		Type: AdminAnalyticsTypePublicChannel,
+		Date: "2026-10-01",
+	})
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []PublicChannelAnalytics
+	for record, err := range f.PublicChannelAnalytics() {
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+
+	require.Len(t, records, 1)
+	assert.Equal(t, "Acme", records[0].OriginatingTeam.Name)
+	assert.Equal(t, 40, records[0].TotalMembersCount)
+	assert.Equal(t, []AdminAnalyticsOrganization{{Name: "Other", Domain: "other"}}, records[0].ExternallySharedWithOrganizations)
+}
+
+func TestAdminAnalyticsPublicChannelMetadata(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.analytics.getFile", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.FormValue("metadata_only"))
+		assert.Empty(t, r.FormValue("date"))
+		mockAdminAnalyticsHandler(t, "public_channel",
+			`{"channel_id":"C1","name":"general","topic":"hello","description":"d","date":"2026-10-01"}`,
+		)(rw, r)
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	var records []PublicChannelMetadata
+	for record, err := range api.AdminAnalyticsPublicChannelMetadata(context.Background()) {
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+
+	require.Len(t, records, 1)
+	assert.Equal(t, PublicChannelMetadata{ChannelID: "C1", Name: "general", Topic: "hello", Description: "d", Date: "2026-10-01"}, records[0])
+}
+
+func TestAdminAnalyticsGetFileError(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.analytics.getFile", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": false, "error": "file_not_yet_available"}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	count := 0
+	for _, err := range api.AdminAnalyticsMembers(context.Background(), "2026-10-01") {
+		count++
+		require.EqualError(t, err, "file_not_yet_available")
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestAdminAnalyticsMalformedRecord(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.analytics.getFile", mockAdminAnalyticsHandler(t, "member",
+		`{"user_id":"W1"}`,
+		`{"user_id":`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	var ids []string
+	var lastErr error
+	for record, err := range api.AdminAnalyticsMembers(context.Background(), "2026-10-01") {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		ids = append(ids, record.UserID)
+	}
+
+	assert.Equal(t, []string{"W1"}, ids)
+	assert.Error(t, lastErr)
+}
+
+func TestAdminAnalyticsGetFileWithToken(t *testing.T) {
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.FormValue("token"))
+		mockAdminAnalyticsHandler(t, "member", `{"user_id":"W1"}`)(rw, r)
+	}))
+	defer server.Close()
+	api := New("xoxp-default", OptionAPIURL(server.URL+"/"))
+
+	f, err := api.AdminAnalyticsGetFile(WithToken(context.Background(), "xoxp-other"), AdminAnalyticsGetFileParams{Type: AdminAnalyticsTypeMember})
+	require.NoError(t, err)
+	f.Close()
+	assert.Equal(t, []string{"xoxp-other"}, tokens)
+
+	_, err = api.AdminAnalyticsGetFile(WithToken(context.Background(), "xoxb-bot"), AdminAnalyticsGetFileParams{Type: AdminAnalyticsTypeMember})
+	assert.ErrorIs(t, err, ErrNotAllowedTokenType)
+	assert.Len(t, tokens, 1)
+}
+
+func TestAdminAnalyticsGetFileObserved(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.FormValue("date") == "2026-10-01" {
+			mockAdminAnalyticsHandler(t, "member", `{"user_id":"W1"}`)(rw, r)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": false, "error": "file_not_yet_available"}`))
+	}))
+	defer server.Close()
+	observer := &recordingAPIObserver{}
+	api := New("xoxp-test", OptionAPIURL(server.URL+"/"), OptionAPIObserver(observer))
+
+	f, err := api.AdminAnalyticsGetFile(context.Background(), AdminAnalyticsGetFileParams{Type: AdminAnalyticsTypeMember, Date: "2026-10-01"})
+	require.NoError(t, err)
+	f.Close()
+	_, err = api.AdminAnalyticsGetFile(context.Background(), AdminAnalyticsGetFileParams{Type: AdminAnalyticsTypeMember, Date: "2026-10-02"})
+	require.EqualError(t, err, "file_not_yet_available")
+
+	assert.Equal(t, []APICall{
+		{Method: "admin.analytics.getFile", OK: true},
+		{Method: "admin.analytics.getFile", ErrorCode: "file_not_yet_available"},
+	}, observer.calls)
+}
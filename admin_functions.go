@@ -0,0 +1,121 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type (
+	// AdminFunctionParameter is an input or output parameter of a function.
+	AdminFunctionParameter struct {
+		Name        string          `json:"name"`
+		Type        string          `json:"type"`
+		Title       string          `json:"title,omitempty"`
+		Description string          `json:"description,omitempty"`
+		IsRequired  bool            `json:"is_required"`
+		Schema      json.RawMessage `json:"schema,omitempty"`
+	}
+
+	// AdminFunction is the function metadata returned by admin.functions.list.
+	AdminFunction struct {
+		ID               string                   `json:"id"`
+		CallbackID       string                   `json:"callback_id"`
+		Title            string                   `json:"title"`
+		Description      string                   `json:"description"`
+		Type             string                   `json:"type"`
+		AppID            string                   `json:"app_id"`
+		InputParameters  []AdminFunctionParameter `json:"input_parameters,omitempty"`
+		OutputParameters []AdminFunctionParameter `json:"output_parameters,omitempty"`
+		FormEnabled      bool                     `json:"form_enabled"`
+		DateCreated      int64                    `json:"date_created"`
+		DateUpdated      int64                    `json:"date_updated"`
+		DateDeleted      int64                    `json:"date_deleted"`
+	}
+
+	AdminFunctionsListInput struct {
+		AppIDs []string `json:"app_ids"`
+		TeamID string   `json:"team_id,omitempty"`
+		Cursor string   `json:"cursor,omitempty"`
+		Limit  int      `json:"limit,omitempty"`
+	}
+
+	AdminFunctionsListOutput struct {
+		Functions  []AdminFunction `json:"functions"`
+		NextCursor string          `json:"-"`
+	}
+
+	AdminFunctionsPermissionsLookupInput struct {
+		FunctionIDs []string `json:"function_ids"`
+	}
+
+	AdminFunctionsPermissionsLookupOutput struct {
+		// Permissions is keyed by function ID.
+		Permissions map[string]NamedEntityPermission `json:"permissions"`
+	}
+
+	// AdminFunctionsPermissionsSetInput sets who can run a function. Visibility
+	// is one of the NamedEntityPermission* constants; UserIDs is only used with
+	// named_entities.
+	AdminFunctionsPermissionsSetInput struct {
+		FunctionID string   `json:"function_id"`
+		Visibility string   `json:"visibility"`
+		UserIDs    []string `json:"user_ids,omitempty"`
+	}
+)
+
+// AdminFunctionsList looks up functions by a set of apps.
+//
+// Slack API Docs:https://api.slack.com/methods/admin.functions.list
+func (api *Client) AdminFunctionsList(ctx context.Context, input *AdminFunctionsListInput) (*AdminFunctionsListOutput, error) {
+	response := struct {
+		SlackResponse
+		AdminFunctionsListOutput
+	}{}
+
+	err := api.adminWorkflowsRequest(ctx, "admin.functions.list", input, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := response.Err(); err != nil {
+		return nil, err
+	}
+
+	response.NextCursor = response.ResponseMetadata.Cursor
+	return &response.AdminFunctionsListOutput, nil
+}
+
+// AdminFunctionsPermissionsLookup looks up the visibility of multiple functions.
+//
+// Slack API Docs:https://api.slack.com/methods/admin.functions.permissions.lookup
+func (api *Client) AdminFunctionsPermissionsLookup(ctx context.Context, input *AdminFunctionsPermissionsLookupInput) (*AdminFunctionsPermissionsLookupOutput, error) {
+	response := struct {
+		SlackResponse
+		AdminFunctionsPermissionsLookupOutput
+	}{}
+
+	err := api.adminWorkflowsRequest(ctx, "admin.functions.permissions.lookup", input, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := response.Err(); err != nil {
+		return nil, err
+	}
+
+	return &response.AdminFunctionsPermissionsLookupOutput, nil
+}
+
+// AdminFunctionsPermissionsSet sets the visibility of a function.
+//
+// Slack API Docs:https://api.slack.com/methods/admin.functions.permissions.set
+func (api *Client) AdminFunctionsPermissionsSet(ctx context.Context, input *AdminFunctionsPermissionsSetInput) error {
+	response := SlackResponse{}
+
+	err := api.adminWorkflowsRequest(ctx, "admin.functions.permissions.set", input, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
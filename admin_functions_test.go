@@ -0,0 +1,76 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminFunctionsList(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.functions.list", mockJSONHandler(t,
+		`{"app_ids": ["A0123"], "limit": 10}`,
+		`{
+			"ok": true,
+			"functions": [{
+				"id": "Fn0123",
+				"callback_id": "create_ticket",
+				"title": "Create ticket",
+				"type": "app",
+				"app_id": "A0123",
+				"input_parameters": [{"name": "summary", "type": "string", "is_required": true}],
+				"date_created": 1700000000
+			}],
+			"response_metadata": {"next_cursor": "c2"}
+		}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	out, err := api.AdminFunctionsList(context.Background(), &AdminFunctionsListInput{AppIDs: []string{"A0123"}, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "c2", out.NextCursor)
+	require.Len(t, out.Functions, 1)
+	fn := out.Functions[0]
+	assert.Equal(t, "create_ticket", fn.CallbackID)
+	assert.Equal(t, []AdminFunctionParameter{{Name: "summary", Type: "string", IsRequired: true}}, fn.InputParameters)
+}
+
+func TestAdminFunctionsPermissions(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.functions.permissions.lookup", mockJSONHandler(t,
+		`{"function_ids": ["Fn0123", "Fn0456"]}`,
+		`{
+			"ok": true,
+			"permissions": {
+				"Fn0123": {"permission_type": "named_entities", "user_ids": ["U0001"]},
+				"Fn0456": {"permission_type": "everyone"}
+			}
+		}`,
+	))
+	http.HandleFunc("/admin.functions.permissions.set", mockJSONHandler(t,
+		`{"function_id": "Fn0456", "visibility": "named_entities", "user_ids": ["U0002"]}`,
+		`{"ok": true}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	out, err := api.AdminFunctionsPermissionsLookup(context.Background(), &AdminFunctionsPermissionsLookupInput{
+		FunctionIDs: []string{"Fn0123", "Fn0456"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]NamedEntityPermission{
+		"Fn0123": {PermissionType: NamedEntityPermissionNamedEntities, UserIDs: []string{"U0001"}},
+		"Fn0456": {PermissionType: NamedEntityPermissionEveryone},
+	}, out.Permissions)
+
+	err = api.AdminFunctionsPermissionsSet(context.Background(), &AdminFunctionsPermissionsSetInput{
+		FunctionID: "Fn0456",
+		Visibility: NamedEntityPermissionNamedEntities,
+		UserIDs:    []string{"U0002"},
+	})
+	require.NoError(t, err)
+}
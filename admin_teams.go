@@ -3,6 +3,7 @@ package slack
 import (
 	"context"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -151,3 +152,150 @@ func (api *Client) AdminTeamsSettingsSetName(ctx context.Context, teamID, name s
 	}
 	return response.Err()
 }
+
+// AdminTeamsCreateParams contains arguments for AdminTeamsCreate method call.
+type AdminTeamsCreateParams struct {
+	TeamDomain          string
+	TeamName            string
+	TeamDescription     string
+	TeamDiscoverability TeamDiscoverability
+}
+
+type adminTeamsCreateResponse struct {
+	Team string `json:"team"`
+	SlackResponse
+}
+
+// AdminTeamsCreate creates an Enterprise team and returns its ID.
+// Slack API docs: https://docs.slack.dev/reference/methods/admin.teams.create
+func (api *Client) AdminTeamsCreate(ctx context.Context, params AdminTeamsCreateParams) (string, error) {
+	values := url.Values{
+		"token":       {api.token},
+		"team_domain": {params.TeamDomain},
+		"team_name":   {params.TeamName},
+	}
+
+	if params.TeamDescription != "" {
+		values.Add("team_description", params.TeamDescription)
+	}
+
+	if params.TeamDiscoverability != "" {
+		values.Add("team_discoverability", string(params.TeamDiscoverability))
+	}
+
+	response := &adminTeamsCreateResponse{}
+	err := api.postMethod(ctx, "admin.teams.create", values, response)
+	if err != nil {
+		return "", err
+	}
+	return response.Team, response.Err()
+}
+
+// AdminTeamPrimaryOwner identifies the primary owner of a workspace.
+type AdminTeamPrimaryOwner struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// AdminTeam contains a workspace returned by admin.teams.list.
+type AdminTeam struct {
+	ID              string                `json:"id"`
+	Name            string                `json:"name"`
+	Discoverability TeamDiscoverability   `json:"discoverability"`
+	PrimaryOwner    AdminTeamPrimaryOwner `json:"primary_owner"`
+	TeamURL         string                `json:"team_url"`
+}
+
+// AdminTeamsListParams contains arguments for AdminTeamsList method call.
+type AdminTeamsListParams struct {
+	Cursor string
+	Limit  int
+}
+
+type adminTeamsListResponse struct {
+	Teams []AdminTeam `json:"teams"`
+	SlackResponse
+}
+
+// AdminTeamsList lists all teams in an Enterprise organization. It returns the
+// teams and the cursor for the next page, which is empty on the last page.
+// Slack API docs: https://docs.slack.dev/reference/methods/admin.teams.list
+func (api *Client) AdminTeamsList(ctx context.Context, params AdminTeamsListParams) ([]AdminTeam, string, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+
+	if params.Limit > 0 {
+		values.Add("limit", strconv.Itoa(params.Limit))
+	}
+
+	response := &adminTeamsListResponse{}
+	err := api.postMethod(ctx, "admin.teams.list", values, response)
+	if err != nil {
+		return nil, "", err
+	}
+	return response.Teams, response.ResponseMetadata.Cursor, response.Err()
+}
+
+// AdminTeamsMembersListParams contains arguments for the AdminTeamsAdminsList
+// and AdminTeamsOwnersList method calls.
+type AdminTeamsMembersListParams struct {
+	TeamID string
+	Cursor string
+	Limit  int
+}
+
+type adminTeamsAdminsListResponse struct {
+	AdminIDs []string `json:"admin_ids"`
+	SlackResponse
+}
+
+type adminTeamsOwnersListResponse struct {
+	OwnerIDs []string `json:"owner_ids"`
+	SlackResponse
+}
+
+func (params AdminTeamsMembersListParams) values(token string) url.Values {
+	values := url.Values{
+		"token":   {token},
+		"team_id": {params.TeamID},
+	}
+
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+
+	if params.Limit > 0 {
+		values.Add("limit", strconv.Itoa(params.Limit))
+	}
+
+	return values
+}
+
+// AdminTeamsAdminsList lists the user IDs of all admins on a workspace. It
+// returns the IDs and the cursor for the next page.
+// Slack API docs: https://docs.slack.dev/reference/methods/admin.teams.admins.list
+func (api *Client) AdminTeamsAdminsList(ctx context.Context, params AdminTeamsMembersListParams) ([]string, string, error) {
+	response := &adminTeamsAdminsListResponse{}
+	err := api.postMethod(ctx, "admin.teams.admins.list", params.values(api.token), response)
+	if err != nil {
+		return nil, "", err
+	}
+	return response.AdminIDs, response.ResponseMetadata.Cursor, response.Err()
+}
+
+// AdminTeamsOwnersList lists the user IDs of all owners on a workspace. It
+// returns the IDs and the cursor for the next page.
+// Slack API docs: https://docs.slack.dev/reference/methods/admin.teams.owners.list
+func (api *Client) AdminTeamsOwnersList(ctx context.Context, params AdminTeamsMembersListParams) ([]string, string, error) {
+	response := &adminTeamsOwnersListResponse{}
+	err := api.postMethod(ctx, "admin.teams.owners.list", params.values(api.token), response)
+	if err != nil {
+		return nil, "", err
+	}
+	return response.OwnerIDs, response.ResponseMetadata.Cursor, response.Err()
+}
@@ -117,3 +117,98 @@ func TestAdminTeamsSettingsSetName(t *testing.T) {
 	err := api.AdminTeamsSettingsSetName(context.Background(), "T12345", "New Name")
 	require.NoError(t, err)
 }
+
+func TestAdminTeamsCreate(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.teams.create", func(rw http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "acme-eng", r.FormValue("team_domain"))
+		assert.Equal(t, "Acme Engineering", r.FormValue("team_name"))
+		assert.Equal(t, "invite_only", r.FormValue("team_discoverability"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "team": "T12345"}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	teamID, err := api.AdminTeamsCreate(context.Background(), AdminTeamsCreateParams{
+		TeamDomain:          "acme-eng",
+		TeamName:            "Acme Engineering",
+		TeamDiscoverability: TeamDiscoverabilityInviteOnly,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "T12345", teamID)
+}
+
+func TestAdminTeamsList(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.teams.list", func(rw http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "abc", r.FormValue("cursor"))
+		assert.Equal(t, "2", r.FormValue("limit"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{
+			"ok": true,
+			"teams": [
+				{
+					"id": "T1234",
+					"name": "My Team",
+					"discoverability": "hidden",
+					"primary_owner": {"user_id": "W1234", "email": "bront@slack.com"},
+					"team_url": "https://subarachnoid.slack.com/"
+				}
+			],
+			"response_metadata": {"next_cursor": "dXNlcjpVMEc5V0ZYTlo="}
+		}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	teams, cursor, err := api.AdminTeamsList(context.Background(), AdminTeamsListParams{Cursor: "abc", Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "dXNlcjpVMEc5V0ZYTlo=", cursor)
+	require.Len(t, teams, 1)
+	assert.Equal(t, "T1234", teams[0].ID)
+	assert.Equal(t, "W1234", teams[0].PrimaryOwner.UserID)
+	assert.Equal(t, "bront@slack.com", teams[0].PrimaryOwner.Email)
+	assert.Equal(t, "https://subarachnoid.slack.com/", teams[0].TeamURL)
+}
+
+func TestAdminTeamsAdminsList(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.teams.admins.list", func(rw http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "T12345", r.FormValue("team_id"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "admin_ids": ["U1234"], "response_metadata": {"next_cursor": ""}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	adminIDs, cursor, err := api.AdminTeamsAdminsList(context.Background(), AdminTeamsMembersListParams{TeamID: "T12345"})
+	require.NoError(t, err)
+	assert.Empty(t, cursor)
+	assert.Equal(t, []string{"U1234"}, adminIDs)
+}
+
+func TestAdminTeamsOwnersList(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.teams.owners.list", func(rw http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "T12345", r.FormValue("team_id"))
+		assert.Equal(t, "next", r.FormValue("cursor"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "owner_ids": ["W1234", "W5678"], "response_metadata": {"next_cursor": "more"}}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	ownerIDs, cursor, err := api.AdminTeamsOwnersList(context.Background(), AdminTeamsMembersListParams{TeamID: "T12345", Cursor: "next"})
+	require.NoError(t, err)
+	assert.Equal(t, "more", cursor)
+	assert.Equal(t, []string{"W1234", "W5678"}, ownerIDs)
+}
@@ -0,0 +1,183 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// AdminUserGroupsAddChannelsParams contains arguments for AdminUserGroupsAddChannels method call.
+type AdminUserGroupsAddChannelsParams struct {
+	UserGroupID string
+	ChannelIDs  []string
+	TeamID      string
+}
+
+// AdminUserGroupsAddChannels adds up to 100 default channels to an IDP group.
+// For more information see the admin.usergroups.addChannels docs:
+// https://api.slack.com/methods/admin.usergroups.addChannels
+func (api *Client) AdminUserGroupsAddChannels(ctx context.Context, params AdminUserGroupsAddChannelsParams) error {
+	values := url.Values{
+		"token":        {api.token},
+		"usergroup_id": {params.UserGroupID},
+		"channel_ids":  {strings.Join(params.ChannelIDs, ",")},
+	}
+
+	if params.TeamID != "" {
+		values.Add("team_id", params.TeamID)
+	}
+
+	response := &SlackResponse{}
+	err := api.postMethod(ctx, "admin.usergroups.addChannels", values, response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// AdminUserGroupsAddTeamsParams contains arguments for AdminUserGroupsAddTeams method call.
+type AdminUserGroupsAddTeamsParams struct {
+	UserGroupID   string
+	TeamIDs       []string
+	AutoProvision bool
+}
+
+// AdminUserGroupsAddTeams associates one or more default workspaces with an
+// organization-wide IDP group.
+// For more information see the admin.usergroups.addTeams docs:
+// https://api.slack.com/methods/admin.usergroups.addTeams
+func (api *Client) AdminUserGroupsAddTeams(ctx context.Context, params AdminUserGroupsAddTeamsParams) error {
+	values := url.Values{
+		"token":        {api.token},
+		"usergroup_id": {params.UserGroupID},
+		"team_ids":     {strings.Join(params.TeamIDs, ",")},
+	}
+
+	if params.AutoProvision {
+		values.Add("auto_provision", "true")
+	}
+
+	response := &SlackResponse{}
+	err := api.postMethod(ctx, "admin.usergroups.addTeams", values, response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+type adminUserGroupsListChannelsParams struct {
+	teamID            string
+	includeNumMembers bool
+	cursor            string
+	limit             int
+}
+
+// AdminUserGroupsListChannelsOption is an option for AdminUserGroupsListChannels.
+type AdminUserGroupsListChannelsOption func(*adminUserGroupsListChannelsParams)
+
+// AdminUserGroupsListChannelsOptionTeamID sets the workspace the IDP group belongs to.
+func AdminUserGroupsListChannelsOptionTeamID(teamID string) AdminUserGroupsListChannelsOption {
+	return func(params *adminUserGroupsListChannelsParams) {
+		params.teamID = teamID
+	}
+}
+
+// AdminUserGroupsListChannelsOptionIncludeNumMembers includes the member count of each channel.
+func AdminUserGroupsListChannelsOptionIncludeNumMembers(includeNumMembers bool) AdminUserGroupsListChannelsOption {
+	return func(params *adminUserGroupsListChannelsParams) {
+		params.includeNumMembers = includeNumMembers
+	}
+}
+
+// AdminUserGroupsListChannelsOptionCursor sets the cursor for pagination.
+func AdminUserGroupsListChannelsOptionCursor(cursor string) AdminUserGroupsListChannelsOption {
+	return func(params *adminUserGroupsListChannelsParams) {
+		params.cursor = cursor
+	}
+}
+
+// AdminUserGroupsListChannelsOptionLimit sets the maximum number of results to return.
+func AdminUserGroupsListChannelsOptionLimit(limit int) AdminUserGroupsListChannelsOption {
+	return func(params *adminUserGroupsListChannelsParams) {
+		params.limit = limit
+	}
+}
+
+// AdminUserGroupChannel represents a default channel linked to an IDP group.
+type AdminUserGroupChannel struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	TeamID     string `json:"team_id"`
+	NumMembers int    `json:"num_members,omitempty"`
+	IsRedacted bool   `json:"is_redacted,omitempty"`
+}
+
+// AdminUserGroupsListChannelsResponse represents the response from admin.usergroups.listChannels.
+type AdminUserGroupsListChannelsResponse struct {
+	SlackResponse
+	Channels []AdminUserGroupChannel `json:"channels"`
+}
+
+// AdminUserGroupsListChannels lists the default channels linked to an IDP group.
+// It returns the channels and the cursor for the next page, which is empty
+// once all channels have been returned.
+// For more information see the admin.usergroups.listChannels docs:
+// https://api.slack.com/methods/admin.usergroups.listChannels
+func (api *Client) AdminUserGroupsListChannels(ctx context.Context, userGroupID string, options ...AdminUserGroupsListChannelsOption) ([]AdminUserGroupChannel, string, error) {
+	params := adminUserGroupsListChannelsParams{}
+	for _, opt := range options {
+		opt(&params)
+	}
+
+	values := url.Values{
+		"token":        {api.token},
+		"usergroup_id": {userGroupID},
+	}
+
+	if params.teamID != "" {
+		values.Add("team_id", params.teamID)
+	}
+
+	if params.includeNumMembers {
+		values.Add("include_num_members", "true")
+	}
+
+	if params.cursor != "" {
+		values.Add("cursor", params.cursor)
+	}
+
+	if params.limit > 0 {
+		values.Add("limit", strconv.Itoa(params.limit))
+	}
+
+	response := &AdminUserGroupsListChannelsResponse{}
+	err := api.postMethod(ctx, "admin.usergroups.listChannels", values, response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response.Channels, response.ResponseMetadata.Cursor, response.Err()
+}
+
+// AdminUserGroupsRemoveChannels removes one or more default channels from an
+// org-level IDP group.
+// For more information see the admin.usergroups.removeChannels docs:
+// https://api.slack.com/methods/admin.usergroups.removeChannels
+func (api *Client) AdminUserGroupsRemoveChannels(ctx context.Context, userGroupID string, channelIDs []string) error {
+	values := url.Values{
+		"token":        {api.token},
+		"usergroup_id": {userGroupID},
+		"channel_ids":  {strings.Join(channelIDs, ",")},
+	}
+
+	response := &SlackResponse{}
+	err := api.postMethod(ctx, "admin.usergroups.removeChannels", values, response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
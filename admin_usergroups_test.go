@@ -0,0 +1,105 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockAdminUserGroupsHandler(t *testing.T, required []string, body string) func(rw http.ResponseWriter, r *http.Request) {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+
+		for _, key := range required {
+			if len(r.Form[key]) == 0 {
+				t.Errorf("missing %s in request", key)
+			}
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(body))
+	}
+}
+
+func TestAdminUserGroupsAddChannels(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.usergroups.addChannels", mockAdminUserGroupsHandler(t, []string{"usergroup_id", "channel_ids", "team_id"}, `{"ok": true}`))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	err := api.AdminUserGroupsAddChannels(context.Background(), AdminUserGroupsAddChannelsParams{
+		UserGroupID: "S123",
+		ChannelIDs:  []string{"C123", "C456"},
+		TeamID:      "T123",
+	})
+	require.NoError(t, err)
+}
+
+func TestAdminUserGroupsAddTeams(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.usergroups.addTeams", mockAdminUserGroupsHandler(t, []string{"usergroup_id", "team_ids", "auto_provision"}, `{"ok": true}`))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	err := api.AdminUserGroupsAddTeams(context.Background(), AdminUserGroupsAddTeamsParams{
+		UserGroupID:   "S123",
+		TeamIDs:       []string{"T123", "T456"},
+		AutoProvision: true,
+	})
+	require.NoError(t, err)
+}
+
+func TestAdminUserGroupsListChannels(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.usergroups.listChannels", mockAdminUserGroupsHandler(t, []string{"usergroup_id", "include_num_members", "cursor"}, `{
+		"ok": true,
+		"channels": [
+			{"id": "C024BE91L", "name": "fun", "team_id": "T024BE911", "num_members": 34},
+			{"id": "C024BE91K", "name": "more fun", "team_id": "T024BE912", "is_redacted": true}
+		],
+		"response_metadata": {"next_cursor": "dXNlcjpVMEc5V0ZYTlo="}
+	}`))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	channels, cursor, err := api.AdminUserGroupsListChannels(context.Background(), "S123",
+		AdminUserGroupsListChannelsOptionIncludeNumMembers(true),
+		AdminUserGroupsListChannelsOptionCursor("abc"),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "dXNlcjpVMEc5V0ZYTlo=", cursor)
+	require.Len(t, channels, 2)
+	assert.Equal(t, AdminUserGroupChannel{ID: "C024BE91L", Name: "fun", TeamID: "T024BE911", NumMembers: 34}, channels[0])
+	assert.True(t, channels[1].IsRedacted)
+}
+
+func TestAdminUserGroupsRemoveChannels(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.usergroups.removeChannels", mockAdminUserGroupsHandler(t, []string{"usergroup_id", "channel_ids"}, `{"ok": true}`))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	err := api.AdminUserGroupsRemoveChannels(context.Background(), "S123", []string{"C123"})
+	require.NoError(t, err)
+}
+
+func TestAdminUserGroupsListChannelsError(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.usergroups.listChannels", mockAdminUserGroupsHandler(t, nil, `{"ok": false, "error": "invalid_usergroup"}`))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	_, _, err := api.AdminUserGroupsListChannels(context.Background(), "S999")
+	require.EqualError(t, err, "invalid_usergroup")
+}
@@ -0,0 +1,207 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	WorkflowSourceCode            = "code"
+	WorkflowSourceWorkflowBuilder = "workflow_builder"
+)
+
+const (
+	NamedEntityPermissionEveryone         = "everyone"
+	NamedEntityPermissionAppCollaborators = "app_collaborators"
+	NamedEntityPermissionNamedEntities    = "named_entities"
+	NamedEntityPermissionNoOne            = "no_one"
+)
+
+type (
+	// NamedEntityPermission describes who is allowed to run a workflow or function.
+	// The ID lists are only set when PermissionType is named_entities.
+	NamedEntityPermission struct {
+		PermissionType string   `json:"permission_type"`
+		UserIDs        []string `json:"user_ids,omitempty"`
+		ChannelIDs     []string `json:"channel_ids,omitempty"`
+		TeamIDs        []string `json:"team_ids,omitempty"`
+		OrgIDs         []string `json:"org_ids,omitempty"`
+	}
+
+	WorkflowIcons struct {
+		Image32  string `json:"image_32,omitempty"`
+		Image48  string `json:"image_48,omitempty"`
+		Image64  string `json:"image_64,omitempty"`
+		Image72  string `json:"image_72,omitempty"`
+		Image96  string `json:"image_96,omitempty"`
+		Image192 string `json:"image_192,omitempty"`
+		Image512 string `json:"image_512,omitempty"`
+	}
+
+	// AdminWorkflow is the workflow metadata returned by admin.workflows.search.
+	AdminWorkflow struct {
+		ID                     string          `json:"id"`
+		WorkflowFunctionID     string          `json:"workflow_function_id"`
+		CallbackID             string          `json:"callback_id"`
+		Title                  string          `json:"title"`
+		Description            string          `json:"description"`
+		AppID                  string          `json:"app_id"`
+		Source                 string          `json:"source"`
+		Collaborators          []string        `json:"collaborators"`
+		Icons                  *WorkflowIcons  `json:"icons,omitempty"`
+		InputParameters        json.RawMessage `json:"input_parameters,omitempty"`
+		Steps                  json.RawMessage `json:"steps,omitempty"`
+		TriggerIDs             []string        `json:"trigger_ids,omitempty"`
+		IsPublished            bool            `json:"is_published"`
+		IsBillable             bool            `json:"is_billable"`
+		IsSalesElevate         bool            `json:"is_sales_elevate"`
+		UnpublishedChangeCount int             `json:"unpublished_change_count"`
+		LastUpdatedBy          string          `json:"last_updated_by"`
+		DateUpdated            int64           `json:"date_updated"`
+		LastPublishedVersionID string          `json:"last_published_version_id,omitempty"`
+		LastPublishedDate      int64           `json:"last_published_date,omitempty"`
+	}
+
+	AdminWorkflowsSearchInput struct {
+		Query           string   `json:"query,omitempty"`
+		AppID           string   `json:"app_id,omitempty"`
+		CollaboratorIDs []string `json:"collaborator_ids,omitempty"`
+		NoCollaborators bool     `json:"no_collaborators,omitempty"`
+		IsSalesElevate  bool     `json:"is_sales_elevate,omitempty"`
+		NumTriggerIDs   int      `json:"num_trigger_ids,omitempty"`
+		Source          string   `json:"source,omitempty"`
+		TriggerTypeID   string   `json:"trigger_type_id,omitempty"`
+		Sort            string   `json:"sort,omitempty"`
+		SortDir         string   `json:"sort_dir,omitempty"`
+		Cursor          string   `json:"cursor,omitempty"`
+		Limit           int      `json:"limit,omitempty"`
+	}
+
+	AdminWorkflowsSearchOutput struct {
+		Workflows  []AdminWorkflow `json:"workflows"`
+		TotalFound int             `json:"total_found"`
+		NextCursor string          `json:"-"`
+	}
+
+	AdminWorkflowsPermissionsLookupInput struct {
+		WorkflowIDs         []string `json:"workflow_ids"`
+		MaxWorkflowTriggers int      `json:"max_workflow_triggers,omitempty"`
+	}
+
+	// AdminWorkflowPermissions holds who can run a workflow. Complete is false
+	// when the workflow has more triggers than were requested.
+	AdminWorkflowPermissions struct {
+		Complete   bool                  `json:"complete"`
+		WhoCanRun  NamedEntityPermission `json:"who_can_run"`
+		TriggerIDs []string              `json:"trigger_ids,omitempty"`
+	}
+
+	AdminWorkflowsPermissionsLookupOutput struct {
+		// Permissions is keyed by workflow ID.
+		Permissions map[string]AdminWorkflowPermissions `json:"permissions"`
+	}
+
+	AdminWorkflowsCollaboratorsInput struct {
+		WorkflowIDs     []string `json:"workflow_ids"`
+		CollaboratorIDs []string `json:"collaborator_ids"`
+	}
+
+	AdminWorkflowsUnpublishInput struct {
+		WorkflowIDs []string `json:"workflow_ids"`
+	}
+)
+
+func (api *Client) adminWorkflowsRequest(ctx context.Context, path string, input any, response any) error {
+	jsonPayload, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %T: %w", input, err)
+	}
+
+	return api.postJSONMethod(ctx, path, jsonPayload, response)
+}
+
+// AdminWorkflowsSearch searches the workflows within the organization.
+//
+// Slack API Docs:https://api.slack.com/methods/admin.workflows.search
+func (api *Client) AdminWorkflowsSearch(ctx context.Context, input *AdminWorkflowsSearchInput) (*AdminWorkflowsSearchOutput, error) {
+	response := struct {
+		SlackResponse
+		AdminWorkflowsSearchOutput
+	}{}
+
+	err := api.adminWorkflowsRequest(ctx, "admin.workflows.search", input, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := response.Err(); err != nil {
+		return nil, err
+	}
+
+	response.NextCursor = response.ResponseMetadata.Cursor
+	return &response.AdminWorkflowsSearchOutput, nil
+}
+
+// AdminWorkflowsPermissionsLookup looks up the permissions for a set of workflows.
+//
+// Slack API Docs:https://api.slack.com/methods/admin.workflows.permissions.lookup
+func (api *Client) AdminWorkflowsPermissionsLookup(ctx context.Context, input *AdminWorkflowsPermissionsLookupInput) (*AdminWorkflowsPermissionsLookupOutput, error) {
+	response := struct {
+		SlackResponse
+		AdminWorkflowsPermissionsLookupOutput
+	}{}
+
+	err := api.adminWorkflowsRequest(ctx, "admin.workflows.permissions.lookup", input, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := response.Err(); err != nil {
+		return nil, err
+	}
+
+	return &response.AdminWorkflowsPermissionsLookupOutput, nil
+}
+
+// AdminWorkflowsCollaboratorsAdd adds collaborators to workflows within the organization.
+//
+// Slack API Docs:https://api.slack.com/methods/admin.workflows.collaborators.add
+func (api *Client) AdminWorkflowsCollaboratorsAdd(ctx context.Context, input *AdminWorkflowsCollaboratorsInput) error {
+	response := SlackResponse{}
+
+	err := api.adminWorkflowsRequest(ctx, "admin.workflows.collaborators.add", input, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// AdminWorkflowsCollaboratorsRemove removes collaborators from workflows within the organization.
+//
+// Slack API Docs:https://api.slack.com/methods/admin.workflows.collaborators.remove
+func (api *Client) AdminWorkflowsCollaboratorsRemove(ctx context.Context, input *AdminWorkflowsCollaboratorsInput) error {
+	response := SlackResponse{}
+
+	err := api.adminWorkflowsRequest(ctx, "admin.workflows.collaborators.remove", input, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// AdminWorkflowsUnpublish unpublishes workflows within the organization.
+//
+// Slack API Docs:https://api.slack.com/methods/admin.workflows.unpublish
+func (api *Client) AdminWorkflowsUnpublish(ctx context.Context, input *AdminWorkflowsUnpublishInput) error {
+	response := SlackResponse{}
+
+	err := api.adminWorkflowsRequest(ctx, "admin.workflows.unpublish", input, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
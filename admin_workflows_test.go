@@ -0,0 +1,120 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminWorkflowsSearch(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.workflows.search", mockJSONHandler(t,
+		`{"query": "onboarding", "source": "workflow_builder", "limit": 20}`,
+		`{
+			"ok": true,
+			"workflows": [{
+				"id": "Wf0123",
+				"workflow_function_id": "Fn0123",
+				"title": "Onboarding",
+				"app_id": "A0123",
+				"source": "workflow_builder",
+				"collaborators": ["U0001", "U0002"],
+				"icons": {"image_96": "https://example.com/96.png"},
+				"trigger_ids": ["Ft0001"],
+				"is_published": true,
+				"unpublished_change_count": 2,
+				"last_updated_by": "U0001",
+				"date_updated": 1700000000
+			}],
+			"total_found": 1,
+			"response_metadata": {"next_cursor": "c2"}
+		}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	out, err := api.AdminWorkflowsSearch(context.Background(), &AdminWorkflowsSearchInput{
+		Query:  "onboarding",
+		Source: WorkflowSourceWorkflowBuilder,
+		Limit:  20,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, out.TotalFound)
+	assert.Equal(t, "c2", out.NextCursor)
+	require.Len(t, out.Workflows, 1)
+	wf := out.Workflows[0]
+	assert.Equal(t, "Wf0123", wf.ID)
+	assert.Equal(t, []string{"U0001", "U0002"}, wf.Collaborators)
+	assert.Equal(t, "https://example.com/96.png", wf.Icons.Image96)
+	assert.True(t, wf.IsPublished)
+	assert.Equal(t, 2, wf.UnpublishedChangeCount)
+}
+
+func TestAdminWorkflowsPermissionsLookup(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.workflows.permissions.lookup", mockJSONHandler(t,
+		`{"workflow_ids": ["Wf0123"]}`,
+		`{
+			"ok": true,
+			"permissions": {
+				"Wf0123": {
+					"complete": true,
+					"who_can_run": {"permission_type": "named_entities", "user_ids": ["U0001"], "channel_ids": ["C0001"]},
+					"trigger_ids": ["Ft0001"]
+				}
+			}
+		}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	out, err := api.AdminWorkflowsPermissionsLookup(context.Background(), &AdminWorkflowsPermissionsLookupInput{
+		WorkflowIDs: []string{"Wf0123"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]AdminWorkflowPermissions{
+		"Wf0123": {
+			Complete: true,
+			WhoCanRun: NamedEntityPermission{
+				PermissionType: NamedEntityPermissionNamedEntities,
+				UserIDs:        []string{"U0001"},
+				ChannelIDs:     []string{"C0001"},
+			},
+			TriggerIDs: []string{"Ft0001"},
+		},
+	}, out.Permissions)
+}
+
+func TestAdminWorkflowsCollaborators(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.workflows.collaborators.add", mockJSONHandler(t,
+		`{"workflow_ids": ["Wf0123"], "collaborator_ids": ["U0003"]}`,
+		`{"ok": true}`,
+	))
+	http.HandleFunc("/admin.workflows.collaborators.remove", mockJSONHandler(t,
+		`{"workflow_ids": ["Wf0123"], "collaborator_ids": ["U0003"]}`,
+		`{"ok": false, "error": "cannot_remove_all_collaborators"}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	input := &AdminWorkflowsCollaboratorsInput{WorkflowIDs: []string{"Wf0123"}, CollaboratorIDs: []string{"U0003"}}
+	require.NoError(t, api.AdminWorkflowsCollaboratorsAdd(context.Background(), input))
+	require.EqualError(t, api.AdminWorkflowsCollaboratorsRemove(context.Background(), input), "cannot_remove_all_collaborators")
+}
+
+func TestAdminWorkflowsUnpublish(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/admin.workflows.unpublish", mockJSONHandler(t,
+		`{"workflow_ids": ["Wf0123", "Wf0456"]}`,
+		`{"ok": true}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	err := api.AdminWorkflowsUnpublish(context.Background(), &AdminWorkflowsUnpublishInput{WorkflowIDs: []string{"Wf0123", "Wf0456"}})
+	require.NoError(t, err)
+}
@@ -0,0 +1,156 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// APICall describes a Web API request reported to an APIObserver.
+type APICall struct {
+	// Method is the API method, e.g. "chat.postMessage". The file content
+	// upload done by UploadToURL is reported as "files.uploadToURL", and SCIM
+	// calls as "scim/<version>/<resource>", e.g. "scim/v2/Users".
+	Method string
+	// TeamID and Channel are taken from the team_id and channel (or
+	// channel_id) request parameters, when present.
+	TeamID  string
+	Channel string
+	// OK reports whether the request succeeded and Slack answered ok.
+	OK bool
+	// ErrorCode is the error returned by Slack, e.g. "channel_not_found", or
+	// "ratelimited" when the request was rate limited.
+	ErrorCode string
+	// Err is the transport, HTTP status or decoding error, if any.
+	Err error
+	// Retries is the number of times the request was retried by the client
+	// configured with OptionRetry or OptionRetryConfig.
+	Retries int
+	// RetryAfter is set when the request was eventually rate limited.
+	RetryAfter time.Duration
+	Duration   time.Duration
+	// Header holds the response headers, nil when no response was read.
+	Header http.Header
+}
+
+// APIObserver is notified of every Web API request, to export metrics or
+// traces. Set it with OptionAPIObserver; the otelslack package provides an
+// OpenTelemetry implementation.
+type APIObserver interface {
+	// StartCall is called before a request is sent. The returned context is
+	// used for the request and passed to EndCall, so that it can carry a
+	// span.
+	StartCall(ctx context.Context, method string) context.Context
+	// EndCall is called once the response has been read.
+	EndCall(ctx context.Context, call APICall)
+}
+
+// OptionAPIObserver reports every Web API request to o.
+func OptionAPIObserver(o APIObserver) func(*Client) {
+	return func(c *Client) {
+		c.apiObserver = o
+	}
+}
+
+// retriesKey is the context key under which retryClient counts retries.
+type retriesKey struct{}
+
+// countRetry records a retry of the request made with ctx.
+func countRetry(ctx context.Context) {
+	if retries, ok := ctx.Value(retriesKey{}).(*int); ok {
+		*retries++
+	}
+}
+
+// startCall notifies the API observer and the slog.Logger that method is
+// being called with request, the url.Values or JSON body sent. The returned
+// function must be called with the response once it is read.
+func (api *Client) startCall(ctx context.Context, method string, request any) (context.Context, func(intf any, headers http.Header, err error)) {
+	if api.apiObserver == nil && api.slog == nil {
+		return ctx, func(any, http.Header, error) {}
+	}
+
+	start := time.Now()
+	retries := new(int)
+	if api.apiObserver != nil {
+		ctx = api.apiObserver.StartCall(ctx, method)
+	}
+	ctx = context.WithValue(ctx, retriesKey{}, retries)
+
+	return ctx, func(intf any, headers http.Header, err error) {
+		call := APICall{
+			Method:   method,
+			Err:      err,
+			Retries:  *retries,
+			Duration: time.Since(start),
+			Header:   headers,
+		}
+		call.TeamID, call.Channel = requestTarget(request)
+
+		if rateLimited, ok := errors.AsType[*RateLimitedError](err); ok {
+			call.ErrorCode = "ratelimited"
+			call.RetryAfter = rateLimited.RetryAfter
+		} else if r, ok := intf.(interface{ Err() error }); ok && err == nil {
+			if slackErr, ok := errors.AsType[SlackErrorResponse](r.Err()); ok {
+				call.ErrorCode = slackErr.Err
+			}
+		}
+		call.OK = err == nil && call.ErrorCode == ""
+
+		api.logCall(ctx, call)
+		if api.apiObserver != nil {
+			api.apiObserver.EndCall(ctx, call)
+		}
+	}
+}
+
+// logCall logs a finished call, at warn level when it failed.
+func (api *Client) logCall(ctx context.Context, call APICall) {
+	level := slog.LevelDebug
+	if !call.OK {
+		level = slog.LevelWarn
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", call.Method),
+		slog.Bool("ok", call.OK),
+		slog.Duration("duration", call.Duration),
+	}
+	if call.ErrorCode != "" {
+		attrs = append(attrs, slog.String("error_code", call.ErrorCode))
+	}
+	if call.Err != nil {
+		attrs = append(attrs, slog.String("error", call.Err.Error()))
+	}
+	if call.Retries > 0 {
+		attrs = append(attrs, slog.Int("retries", call.Retries))
+	}
+	if call.RetryAfter > 0 {
+		attrs = append(attrs, slog.Duration("retry_after", call.RetryAfter))
+	}
+	api.logAttrs(ctx, level, "slack api call", attrs...)
+}
+
+// requestTarget extracts the team and channel parameters from a request.
+func requestTarget(request any) (teamID, channel string) {
+	switch r := request.(type) {
+	case url.Values:
+		channel = r.Get("channel")
+		if channel == "" {
+			channel = r.Get("channel_id")
+		}
+		return r.Get("team_id"), channel
+	case []byte:
+		var params struct {
+			TeamID  string `json:"team_id"`
+			Channel string `json:"channel"`
+		}
+		_ = json.Unmarshal(r, &params)
+		return params.TeamID, params.Channel
+	}
+	return "", ""
+}
@@ -0,0 +1,101 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingAPIObserver struct {
+	mu    sync.Mutex
+	calls []APICall
+}
+
+type observedKey struct{}
+
+func (o *recordingAPIObserver) StartCall(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, observedKey{}, method)
+}
+
+func (o *recordingAPIObserver) EndCall(ctx context.Context, call APICall) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if ctx.Value(observedKey{}) != call.Method {
+		panic("EndCall did not get the context returned by StartCall")
+	}
+	call.Duration, call.Header = 0, nil
+	o.calls = append(o.calls, call)
+}
+
+func TestOptionAPIObserver(t *testing.T) {
+	limited := true
+	mux := http.NewServeMux()
+	mux.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": false, "error": "channel_not_found"}`))
+	})
+	mux.HandleFunc("/conversations.info", func(rw http.ResponseWriter, r *http.Request) {
+		if limited {
+			limited = false
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": {"id": "C1"}}`))
+	})
+	mux.HandleFunc("/users.info", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Retry-After", "30")
+		rw.WriteHeader(http.StatusTooManyRequests)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	observer := &recordingAPIObserver{}
+	retry := DefaultRetryConfig()
+	retry.RetryAfterJitter = 0
+	api := New("xoxb-test", OptionAPIURL(server.URL+"/"), OptionRetryConfig(retry), OptionAPIObserver(observer))
+	noRetry := New("xoxb-test", OptionAPIURL(server.URL+"/"), OptionAPIObserver(observer))
+
+	_, _, err := api.PostMessage("C1", MsgOptionText("hi", false))
+	assert.Error(t, err)
+	_, err = api.GetConversationInfo(&GetConversationInfoInput{ChannelID: "C1"})
+	require.NoError(t, err)
+	_, err = noRetry.GetUserInfo("U1")
+	assert.Error(t, err)
+
+	assert.Equal(t, []APICall{
+		{Method: "chat.postMessage", Channel: "C1", ErrorCode: "channel_not_found"},
+		{Method: "conversations.info", Channel: "C1", OK: true, Retries: 1},
+		{Method: "users.info", ErrorCode: "ratelimited", Err: &RateLimitedError{30 * time.Second}, RetryAfter: 30 * time.Second},
+	}, observer.calls)
+}
+
+func TestOptionAPIObserverSCIM(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/scim/v2/Users/U1", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": "U1"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	observer := &recordingAPIObserver{}
+	api := New("xoxp-test", OptionSCIMAPIURL(server.URL+"/scim/"), OptionSCIMVersion(SCIMVersion2), OptionAPIObserver(observer))
+
+	_, err := api.GetSCIMUser(context.Background(), "U1")
+	require.NoError(t, err)
+	_, err = api.GetSCIMUser(context.Background(), "U2")
+	assert.Error(t, err)
+
+	require.Len(t, observer.calls, 2)
+	assert.Equal(t, APICall{Method: "scim/v2/Users", OK: true}, observer.calls[0])
+	assert.Equal(t, "scim/v2/Users", observer.calls[1].Method)
+	assert.False(t, observer.calls[1].OK)
+}
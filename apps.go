@@ -35,7 +35,7 @@ func (api *Client) ListEventAuthorizationsContext(ctx context.Context, eventCont
 		"event_context": eventContext,
 	})
 
-	err := api.postJSONMethod(ctx, "apps.event.authorizations.list", api.appLevelToken, request, &resp)
+	err := api.postJSONMethodWithExplicitToken(ctx, "apps.event.authorizations.list", api.appLevelToken, request, &resp)
 
 	if err != nil {
 		return nil, err
@@ -63,7 +63,7 @@ func (api *Client) UninstallAppContext(ctx context.Context, clientID, clientSecr
 
 	response := SlackResponse{}
 
-	err := api.getMethod(ctx, "apps.uninstall", api.token, values, &response)
+	err := api.getMethod(ctx, "apps.uninstall", values, &response)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,296 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+)
+
+// Datastores belong to apps built on the Slack platform. The generic helpers
+// in this file marshal items to and from any JSON-compatible type T, e.g. a
+// struct with json tags matching the datastore attributes:
+//
+//	type Incident struct {
+//		ID       string `json:"id"`
+//		Severity string `json:"severity"`
+//	}
+//
+//	incident, err := slack.AppsDatastoreGet[Incident](ctx, api, "incidents", "I123")
+
+// AppsDatastoreOption is an option for the apps.datastore methods.
+type AppsDatastoreOption func(*appsDatastoreRequest)
+
+// AppsDatastoreOptionAppID sets the app the datastore belongs to. Required
+// when the token is not issued to the app itself.
+func AppsDatastoreOptionAppID(appID string) AppsDatastoreOption {
+	return func(r *appsDatastoreRequest) {
+		r.AppID = appID
+	}
+}
+
+type appsDatastoreRequest struct {
+	Datastore            string            `json:"datastore"`
+	AppID                string            `json:"app_id,omitempty"`
+	ID                   string            `json:"id,omitempty"`
+	IDs                  []string          `json:"ids,omitempty"`
+	Item                 any               `json:"item,omitempty"`
+	Items                any               `json:"items,omitempty"`
+	Expression           string            `json:"expression,omitempty"`
+	ExpressionAttributes map[string]string `json:"expression_attributes,omitempty"`
+	ExpressionValues     map[string]any    `json:"expression_values,omitempty"`
+	Limit                int               `json:"limit,omitempty"`
+	Cursor               string            `json:"cursor,omitempty"`
+}
+
+// AppsDatastoreFailedItem identifies an item a bulk operation could not process.
+type AppsDatastoreFailedItem struct {
+	ID    string `json:"id"`
+	Error string `json:"error,omitempty"`
+}
+
+// AppsDatastoreQueryParams contains arguments for AppsDatastoreQuery and
+// AppsDatastoreCount. Expression uses attribute name placeholders (#name)
+// and value placeholders (:value), resolved through ExpressionAttributes
+// and ExpressionValues.
+type AppsDatastoreQueryParams struct {
+	Datastore            string
+	AppID                string
+	Expression           string
+	ExpressionAttributes map[string]string
+	ExpressionValues     map[string]any
+	// Limit and Cursor are only used by AppsDatastoreQuery.
+	Limit  int
+	Cursor string
+}
+
+func (p AppsDatastoreQueryParams) request() appsDatastoreRequest {
+	return appsDatastoreRequest{
+		Datastore:            p.Datastore,
+		AppID:                p.AppID,
+		Expression:           p.Expression,
+		ExpressionAttributes: p.ExpressionAttributes,
+		ExpressionValues:     p.ExpressionValues,
+		Limit:                p.Limit,
+		Cursor:               p.Cursor,
+	}
+}
+
+type appsDatastoreResponse[T any] struct {
+	SlackResponse
+	Datastore   string          `json:"datastore"`
+	Item        T               `json:"item"`
+	Items       []T             `json:"items"`
+	FailedItems json.RawMessage `json:"failed_items"`
+	Count       int             `json:"count"`
+}
+
+func (api *Client) appsDatastoreRequest(ctx context.Context, path string, r appsDatastoreRequest, options []AppsDatastoreOption, response any) error {
+	for _, opt := range options {
+		opt(&r)
+	}
+
+	jsonData, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	return api.postJSONMethod(ctx, path, jsonData, response)
+}
+
+// decodeFailedItems decodes the failed_items of a bulk response, which is
+// omitted when every item succeeded.
+func decodeFailedItems[T any](raw json.RawMessage) ([]T, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var items []T
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// AppsDatastorePut creates or replaces an item and returns the stored item.
+// For more information see the apps.datastore.put docs:
+// https://api.slack.com/methods/apps.datastore.put
+func AppsDatastorePut[T any](ctx context.Context, api *Client, datastore string, item T, options ...AppsDatastoreOption) (T, error) {
+	response := &appsDatastoreResponse[T]{}
+	err := api.appsDatastoreRequest(ctx, "apps.datastore.put", appsDatastoreRequest{Datastore: datastore, Item: item}, options, response)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return response.Item, response.Err()
+}
+
+// AppsDatastoreBulkPut creates or replaces several items at once. It returns
+// the items that could not be stored.
+// For more information see the apps.datastore.bulkPut docs:
+// https://api.slack.com/methods/apps.datastore.bulkPut
+func AppsDatastoreBulkPut[T any](ctx context.Context, api *Client, datastore string, items []T, options ...AppsDatastoreOption) ([]T, error) {
+	response := &appsDatastoreResponse[T]{}
+	err := api.appsDatastoreRequest(ctx, "apps.datastore.bulkPut", appsDatastoreRequest{Datastore: datastore, Items: items}, options, response)
+	if err != nil {
+		return nil, err
+	}
+
+	failed, err := decodeFailedItems[T](response.FailedItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return failed, response.Err()
+}
+
+// AppsDatastoreGet retrieves a single item by its primary key.
+// For more information see the apps.datastore.get docs:
+// https://api.slack.com/methods/apps.datastore.get
+func AppsDatastoreGet[T any](ctx context.Context, api *Client, datastore, id string, options ...AppsDatastoreOption) (T, error) {
+	response := &appsDatastoreResponse[T]{}
+	err := api.appsDatastoreRequest(ctx, "apps.datastore.get", appsDatastoreRequest{Datastore: datastore, ID: id}, options, response)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return response.Item, response.Err()
+}
+
+// AppsDatastoreBulkGet retrieves several items by primary key. It returns
+// the items found and the keys that could not be retrieved.
+// For more information see the apps.datastore.bulkGet docs:
+// https://api.slack.com/methods/apps.datastore.bulkGet
+func AppsDatastoreBulkGet[T any](ctx context.Context, api *Client, datastore string, ids []string, options ...AppsDatastoreOption) ([]T, []AppsDatastoreFailedItem, error) {
+	response := &appsDatastoreResponse[T]{}
+	err := api.appsDatastoreRequest(ctx, "apps.datastore.bulkGet", appsDatastoreRequest{Datastore: datastore, IDs: ids}, options, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	failed, err := decodeFailedItems[AppsDatastoreFailedItem](response.FailedItems)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Items, failed, response.Err()
+}
+
+// AppsDatastoreUpdate edits the attributes of an existing item, leaving
+// attributes that are not set in item unchanged. It returns the updated item.
+// For more information see the apps.datastore.update docs:
+// https://api.slack.com/methods/apps.datastore.update
+func AppsDatastoreUpdate[T any](ctx context.Context, api *Client, datastore string, item T, options ...AppsDatastoreOption) (T, error) {
+	response := &appsDatastoreResponse[T]{}
+	err := api.appsDatastoreRequest(ctx, "apps.datastore.update", appsDatastoreRequest{Datastore: datastore, Item: item}, options, response)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return response.Item, response.Err()
+}
+
+// AppsDatastoreQuery returns a page of items matching the query along with
+// the cursor of the next page, which is empty on the last page.
+// For more information see the apps.datastore.query docs:
+// https://api.slack.com/methods/apps.datastore.query
+func AppsDatastoreQuery[T any](ctx context.Context, api *Client, params AppsDatastoreQueryParams) ([]T, string, error) {
+	response := &appsDatastoreResponse[T]{}
+	err := api.appsDatastoreRequest(ctx, "apps.datastore.query", params.request(), nil, response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response.Items, response.ResponseMetadata.Cursor, response.Err()
+}
+
+// AppsDatastoreQueryAll iterates over every item matching the query,
+// following cursors until the last page. Iteration stops at the first error.
+func AppsDatastoreQueryAll[T any](ctx context.Context, api *Client, params AppsDatastoreQueryParams) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			items, cursor, err := AppsDatastoreQuery[T](ctx, api, params)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if cursor == "" {
+				return
+			}
+			params.Cursor = cursor
+		}
+	}
+}
+
+// AppsDatastoreDelete deletes a single item by its primary key.
+// For more details, see AppsDatastoreDeleteContext documentation.
+func (api *Client) AppsDatastoreDelete(datastore, id string, options ...AppsDatastoreOption) error {
+	return api.AppsDatastoreDeleteContext(context.Background(), datastore, id, options...)
+}
+
+// AppsDatastoreDeleteContext deletes a single item by its primary key with a custom context.
+// For more information see the apps.datastore.delete docs:
+// https://api.slack.com/methods/apps.datastore.delete
+func (api *Client) AppsDatastoreDeleteContext(ctx context.Context, datastore, id string, options ...AppsDatastoreOption) error {
+	response := &SlackResponse{}
+	err := api.appsDatastoreRequest(ctx, "apps.datastore.delete", appsDatastoreRequest{Datastore: datastore, ID: id}, options, response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// AppsDatastoreBulkDelete deletes several items by primary key.
+// For more details, see AppsDatastoreBulkDeleteContext documentation.
+func (api *Client) AppsDatastoreBulkDelete(datastore string, ids []string, options ...AppsDatastoreOption) ([]AppsDatastoreFailedItem, error) {
+	return api.AppsDatastoreBulkDeleteContext(context.Background(), datastore, ids, options...)
+}
+
+// AppsDatastoreBulkDeleteContext deletes several items by primary key with a
+// custom context. It returns the keys that could not be deleted.
+// For more information see the apps.datastore.bulkDelete docs:
+// https://api.slack.com/methods/apps.datastore.bulkDelete
+func (api *Client) AppsDatastoreBulkDeleteContext(ctx context.Context, datastore string, ids []string, options ...AppsDatastoreOption) ([]AppsDatastoreFailedItem, error) {
+	response := &appsDatastoreResponse[json.RawMessage]{}
+	err := api.appsDatastoreRequest(ctx, "apps.datastore.bulkDelete", appsDatastoreRequest{Datastore: datastore, IDs: ids}, options, response)
+	if err != nil {
+		return nil, err
+	}
+
+	failed, err := decodeFailedItems[AppsDatastoreFailedItem](response.FailedItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return failed, response.Err()
+}
+
+// AppsDatastoreCount counts the items matching the query.
+// For more details, see AppsDatastoreCountContext documentation.
+func (api *Client) AppsDatastoreCount(params AppsDatastoreQueryParams) (int, error) {
+	return api.AppsDatastoreCountContext(context.Background(), params)
+}
+
+// AppsDatastoreCountContext counts the items matching the query with a custom context.
+// For more information see the apps.datastore.count docs:
+// https://api.slack.com/methods/apps.datastore.count
+func (api *Client) AppsDatastoreCountContext(ctx context.Context, params AppsDatastoreQueryParams) (int, error) {
+	r := params.request()
+	r.Limit, r.Cursor = 0, ""
+
+	response := &appsDatastoreResponse[json.RawMessage]{}
+	err := api.appsDatastoreRequest(ctx, "apps.datastore.count", r, nil, response)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Count, response.Err()
+}
@@ -0,0 +1,174 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type datastoreIncident struct {
+	ID       string `json:"id"`
+	Severity string `json:"severity,omitempty"`
+}
+
+func TestAppsDatastorePut(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/apps.datastore.put", mockJSONHandler(t,
+		`{"datastore": "incidents", "app_id": "A123", "item": {"id": "I1", "severity": "high"}}`,
+		`{"ok": true, "datastore": "incidents", "item": {"id": "I1", "severity": "high"}}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	item, err := AppsDatastorePut(context.Background(), api, "incidents",
+		datastoreIncident{ID: "I1", Severity: "high"},
+		AppsDatastoreOptionAppID("A123"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, datastoreIncident{ID: "I1", Severity: "high"}, item)
+}
+
+func TestAppsDatastoreBulkPut(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/apps.datastore.bulkPut", mockJSONHandler(t,
+		`{"datastore": "incidents", "items": [{"id": "I1"}, {"id": "I2"}]}`,
+		`{"ok": true, "datastore": "incidents", "failed_items": [{"id": "I2"}]}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	failed, err := AppsDatastoreBulkPut(context.Background(), api, "incidents", []datastoreIncident{{ID: "I1"}, {ID: "I2"}})
+	require.NoError(t, err)
+	assert.Equal(t, []datastoreIncident{{ID: "I2"}}, failed)
+}
+
+func TestAppsDatastoreGet(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/apps.datastore.get", mockJSONHandler(t,
+		`{"datastore": "incidents", "id": "I1"}`,
+		`{"ok": true, "datastore": "incidents", "item": {"id": "I1", "severity": "low"}}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	item, err := AppsDatastoreGet[datastoreIncident](context.Background(), api, "incidents", "I1")
+	require.NoError(t, err)
+	assert.Equal(t, "low", item.Severity)
+
+	raw, err := AppsDatastoreGet[map[string]any](context.Background(), api, "incidents", "I1")
+	require.NoError(t, err)
+	assert.Equal(t, "low", raw["severity"])
+}
+
+func TestAppsDatastoreBulkGet(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/apps.datastore.bulkGet", mockJSONHandler(t,
+		`{"datastore": "incidents", "ids": ["I1", "I9"]}`,
+		`{"ok": true, "datastore": "incidents", "items": [{"id": "I1"}], "failed_items": [{"id": "I9", "error": "not_found"}]}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	items, failed, err := AppsDatastoreBulkGet[datastoreIncident](context.Background(), api, "incidents", []string{"I1", "I9"})
+	require.NoError(t, err)
+	assert.Equal(t, []datastoreIncident{{ID: "I1"}}, items)
+	assert.Equal(t, []AppsDatastoreFailedItem{{ID: "I9", Error: "not_found"}}, failed)
+}
+
+func TestAppsDatastoreUpdate(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/apps.datastore.update", mockJSONHandler(t,
+		`{"datastore": "incidents", "item": {"id": "I1", "severity": "critical"}}`,
+		`{"ok": false, "error": "datastore_error"}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	_, err := AppsDatastoreUpdate(context.Background(), api, "incidents", datastoreIncident{ID: "I1", Severity: "critical"})
+	require.EqualError(t, err, "datastore_error")
+}
+
+func TestAppsDatastoreQueryAll(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/apps.datastore.query", func(rw http.ResponseWriter, r *http.Request) {
+		var req appsDatastoreRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); !assert.NoError(t, err) {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		assert.Equal(t, "#severity = :severity", req.Expression)
+		assert.Equal(t, map[string]string{"#severity": "severity"}, req.ExpressionAttributes)
+		assert.Equal(t, map[string]any{":severity": "high"}, req.ExpressionValues)
+
+		rw.Header().Set("Content-Type", "application/json")
+		if req.Cursor == "" {
+			rw.Write([]byte(`{"ok": true, "items": [{"id": "I1"}, {"id": "I2"}], "response_metadata": {"next_cursor": "c2"}}`))
+			return
+		}
+		rw.Write([]byte(`{"ok": true, "items": [{"id": "I3"}]}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	var ids []string
+	for item, err := range AppsDatastoreQueryAll[datastoreIncident](context.Background(), api, AppsDatastoreQueryParams{
+		Datastore:            "incidents",
+		Expression:           "#severity = :severity",
+		ExpressionAttributes: map[string]string{"#severity": "severity"},
+		ExpressionValues:     map[string]any{":severity": "high"},
+	}) {
+		require.NoError(t, err)
+		ids = append(ids, item.ID)
+	}
+	assert.Equal(t, []string{"I1", "I2", "I3"}, ids)
+}
+
+func TestAppsDatastoreDelete(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/apps.datastore.delete", mockJSONHandler(t,
+		`{"datastore": "incidents", "id": "I1"}`,
+		`{"ok": true, "datastore": "incidents"}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	require.NoError(t, api.AppsDatastoreDelete("incidents", "I1"))
+}
+
+func TestAppsDatastoreBulkDelete(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/apps.datastore.bulkDelete", mockJSONHandler(t,
+		`{"datastore": "incidents", "ids": ["I1", "I2"]}`,
+		`{"ok": true, "datastore": "incidents"}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	failed, err := api.AppsDatastoreBulkDelete("incidents", []string{"I1", "I2"})
+	require.NoError(t, err)
+	assert.Empty(t, failed)
+}
+
+func TestAppsDatastoreCount(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/apps.datastore.count", mockJSONHandler(t,
+		`{"datastore": "incidents", "expression": "#s = :s", "expression_attributes": {"#s": "severity"}, "expression_values": {":s": "low"}}`,
+		`{"ok": true, "datastore": "incidents", "count": 7}`,
+	))
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	count, err := api.AppsDatastoreCount(AppsDatastoreQueryParams{
+		Datastore:            "incidents",
+		Expression:           "#s = :s",
+		ExpressionAttributes: map[string]string{"#s": "severity"},
+		ExpressionValues:     map[string]any{":s": "low"},
+		Limit:                10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 7, count)
+}
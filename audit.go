@@ -2,6 +2,7 @@ package slack
 
 import (
 	"context"
+	"net/http"
 	"net/url"
 	"strconv"
 )
@@ -108,7 +109,9 @@ type AuditLogParameters struct {
 func (api *Client) auditLogsRequest(ctx context.Context, path string, values url.Values) (*AuditLogResponse, error) {
 	response := &AuditLogResponse{}
 	// The Audit Logs API uses a different base URL (api.slack.com instead of slack.com/api)
-	_, err := getResource(ctx, api.httpclient, api.auditEndpoint+path, api.token, values, response, api)
+	err := api.doWithToken(ctx, path, values, response, func(ctx context.Context, token string) (http.Header, error) {
+		return getResource(ctx, api.httpclient, api.auditEndpoint+path, token, values, response, api)
+	})
 	if err != nil {
 		return nil, err
 	}
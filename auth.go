@@ -12,10 +12,16 @@ type AuthRevokeResponse struct {
 	Revoked       bool `json:"revoked,omitempty"`
 }
 
-// authRequest sends the actual request, and unmarshals the response
-func (api *Client) authRequest(ctx context.Context, path string, values url.Values) (*AuthRevokeResponse, error) {
+// authRequest sends the actual request with token, or with the client token
+// when token is empty, and unmarshals the response
+func (api *Client) authRequest(ctx context.Context, path string, token string) (*AuthRevokeResponse, error) {
 	response := &AuthRevokeResponse{}
-	err := api.postMethod(ctx, path, values, response)
+	var err error
+	if token == "" {
+		err = api.postMethod(ctx, path, url.Values{"token": {api.token}}, response)
+	} else {
+		err = api.postMethodWithExplicitToken(ctx, path, token, url.Values{}, response)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -32,14 +38,7 @@ func (api *Client) SendAuthRevoke(token string) (*AuthRevokeResponse, error) {
 // SendAuthRevokeContext will send a revocation request for our token to api.revoke with a custom context.
 // Slack API docs: https://api.slack.com/methods/auth.revoke
 func (api *Client) SendAuthRevokeContext(ctx context.Context, token string) (*AuthRevokeResponse, error) {
-	if token == "" {
-		token = api.token
-	}
-	values := url.Values{
-		"token": {token},
-	}
-
-	return api.authRequest(ctx, "auth.revoke", values)
+	return api.authRequest(ctx, "auth.revoke", token)
 }
 
 type listTeamsResponse struct {
@@ -0,0 +1,320 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/slack-go/slack/internal/backoff"
+)
+
+const (
+	// DefaultBatchConcurrency is the number of calls a Batch makes at once.
+	DefaultBatchConcurrency = 4
+	// DefaultBatchMaxAttempts is how many times a Batch makes a call that
+	// keeps failing, not counting rate limited attempts.
+	DefaultBatchMaxAttempts = 3
+)
+
+// batchUsersInfoChunk is the number of users GetUsersInfo asks for at once.
+const batchUsersInfoChunk = 100
+
+// BatchOption configures a Batch.
+type BatchOption func(*Batch)
+
+// BatchOptionConcurrency sets the number of calls made at once,
+// DefaultBatchConcurrency by default.
+func BatchOptionConcurrency(n int) BatchOption {
+	return func(b *Batch) {
+		b.concurrency = n
+	}
+}
+
+// BatchOptionMaxAttempts sets how many times a call is made when it fails
+// with a retryable error, DefaultBatchMaxAttempts by default. Rate limited
+// attempts are not counted.
+func BatchOptionMaxAttempts(n int) BatchOption {
+	return func(b *Batch) {
+		b.maxAttempts = n
+	}
+}
+
+// BatchOptionBackoff sets the wait between attempts after a retryable error
+// other than a rate limit, which doubles from initial up to max.
+func BatchOptionBackoff(initial, max time.Duration) BatchOption {
+	return func(b *Batch) {
+		b.backoffInitial = initial
+		b.backoffMax = max
+	}
+}
+
+// Batch makes many calls with bounded concurrency, e.g. to message every
+// member of a user group. When a call is rate limited, no new call is started
+// until the Retry-After delay of the RateLimitedError has passed, then the
+// call is made again. Calls failing with other retryable errors are made
+// again with exponential backoff. A failed call does not stop the others;
+// their errors are reported together in a *BatchError.
+type Batch struct {
+	api            *Client
+	concurrency    int
+	maxAttempts    int
+	backoffInitial time.Duration
+	backoffMax     time.Duration
+}
+
+// NewBatch returns a Batch making calls with api.
+func (api *Client) NewBatch(options ...BatchOption) *Batch {
+	b := &Batch{
+		api:         api,
+		concurrency: DefaultBatchConcurrency,
+		maxAttempts: DefaultBatchMaxAttempts,
+	}
+	for _, opt := range options {
+		opt(b)
+	}
+	return b
+}
+
+// BatchError is returned when some calls of a batch failed.
+type BatchError struct {
+	// Errors holds the error of each call, in the order of the items of the
+	// batch, nil for those that succeeded.
+	Errors []error
+}
+
+// Failed returns the indexes of the items whose call failed.
+func (e *BatchError) Failed() []int {
+	var failed []int
+	for i, err := range e.Errors {
+		if err != nil {
+			failed = append(failed, i)
+		}
+	}
+	return failed
+}
+
+func (e *BatchError) Error() string {
+	failed := e.Failed()
+	if len(failed) == 0 {
+		return "slack: batch succeeded"
+	}
+	return fmt.Sprintf("slack: %d of %d calls failed, first: %v", len(failed), len(e.Errors), e.Errors[failed[0]])
+}
+
+// Unwrap returns the errors of the failed calls, so that errors.Is and
+// errors.As match any of them.
+func (e *BatchError) Unwrap() []error {
+	return slices.DeleteFunc(slices.Clone(e.Errors), func(err error) bool { return err == nil })
+}
+
+// Run calls call with the indexes 0 to n-1, and returns a *BatchError if any
+// of the calls failed. Results are typically stored by call in a slice
+// indexed likewise. Calls not made because ctx is done fail with the context
+// error.
+func (b *Batch) Run(ctx context.Context, n int, call func(ctx context.Context, i int) error) error {
+	errs := make([]error, n)
+	gate := &batchGate{}
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for range min(max(b.concurrency, 1), n) {
+		wg.Go(func() {
+			for i := range indexes {
+				errs[i] = b.call(ctx, gate, i, call)
+			}
+		})
+	}
+
+feed:
+	for i := range n {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			for j := i; j < n; j++ {
+				errs[j] = ctx.Err()
+			}
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if slices.ContainsFunc(errs, func(err error) bool { return err != nil }) {
+		return &BatchError{Errors: errs}
+	}
+	return nil
+}
+
+// call makes the call for item i until it succeeds or fails for good.
+func (b *Batch) call(ctx context.Context, gate *batchGate, i int, call func(ctx context.Context, i int) error) error {
+	bo := &backoff.Backoff{Initial: b.backoffInitial, Max: b.backoffMax}
+	failures := 0
+	for attempt := 1; ; attempt++ {
+		if !gate.wait(ctx) {
+			return ctx.Err()
+		}
+		err := call(ctx, i)
+		if final, ok := errors.AsType[batchFinalError](err); ok {
+			return final.err
+		}
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+
+		var wait time.Duration
+		if rateLimited, ok := errors.AsType[*RateLimitedError](err); ok {
+			wait = rateLimited.RetryAfter
+			gate.pause(wait)
+		} else if failures++; failures < b.maxAttempts && retryLater(err) {
+			wait = bo.Duration()
+		} else {
+			return err
+		}
+		b.api.logAttrs(ctx, slog.LevelDebug, "slack batch retry",
+			slog.Int("item", i),
+			slog.Int("attempt", attempt),
+			slog.Duration("wait", wait),
+			slog.String("error", err.Error()),
+		)
+		if !sleepWithContext(ctx, wait) {
+			return err
+		}
+	}
+}
+
+// batchFinalError wraps the error of a call that must not be made again,
+// e.g. because it may have had an effect.
+type batchFinalError struct {
+	err error
+}
+
+func (e batchFinalError) Error() string { return e.err.Error() }
+
+func (e batchFinalError) Unwrap() error { return e.err }
+
+// batchGate holds back the calls of a batch while it is rate limited.
+type batchGate struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+// pause holds back calls for d.
+func (g *batchGate) pause(d time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if until := time.Now().Add(d); until.After(g.until) {
+		g.until = until
+	}
+}
+
+// wait blocks while calls are held back, reporting false if ctx is done
+// first.
+func (g *batchGate) wait(ctx context.Context) bool {
+	for {
+		g.mu.Lock()
+		d := time.Until(g.until)
+		g.mu.Unlock()
+		if d <= 0 {
+			return ctx.Err() == nil
+		}
+		if !sleepWithContext(ctx, d) {
+			return false
+		}
+	}
+}
+
+// BatchMessage is a message posted by a Batch.
+type BatchMessage struct {
+	Channel   string
+	Timestamp string
+}
+
+// PostDirectMessages opens a direct message conversation with each user and
+// posts a message built from options to it. The messages are returned in the
+// order of userIDs; those that failed are left empty and reported in the
+// *BatchError.
+//
+// A conversation is opened once, even if posting to it is retried. Posting is
+// only retried when rate limited: other failures, such as a timeout, do not
+// tell whether the message was posted, so it is not posted again.
+func (b *Batch) PostDirectMessages(ctx context.Context, userIDs []string, options ...MsgOption) ([]BatchMessage, error) {
+	messages := make([]BatchMessage, len(userIDs))
+	err := b.Run(ctx, len(userIDs), func(ctx context.Context, i int) error {
+		if messages[i].Channel == "" {
+			channel, _, _, err := b.api.OpenConversationContext(ctx, &OpenConversationParameters{Users: []string{userIDs[i]}})
+			if err != nil {
+				return err
+			}
+			messages[i].Channel = channel.ID
+		}
+		var err error
+		_, messages[i].Timestamp, err = b.api.PostMessageContext(ctx, messages[i].Channel, options...)
+		if _, ok := errors.AsType[*RateLimitedError](err); err != nil && !ok {
+			return batchFinalError{err}
+		}
+		return err
+	})
+	// Leave the messages that were not posted empty.
+	for i := range messages {
+		if messages[i].Timestamp == "" {
+			messages[i] = BatchMessage{}
+		}
+	}
+	return messages, err
+}
+
+// PostUserGroupDirectMessages posts a direct message to every member of a
+// user group, as PostDirectMessages does. It returns the members along with
+// the messages posted to them.
+func (b *Batch) PostUserGroupDirectMessages(ctx context.Context, userGroup string, options ...MsgOption) ([]string, []BatchMessage, error) {
+	members, err := b.api.GetUserGroupMembersContext(ctx, userGroup)
+	if err != nil {
+		return nil, nil, err
+	}
+	messages, err := b.PostDirectMessages(ctx, members, options...)
+	return members, messages, err
+}
+
+// AddReactions adds the reaction name to each item. Failures are reported in
+// the *BatchError in the order of items.
+func (b *Batch) AddReactions(ctx context.Context, name string, items []ItemRef) error {
+	return b.Run(ctx, len(items), func(ctx context.Context, i int) error {
+		return b.api.AddReactionContext(ctx, name, items[i])
+	})
+}
+
+// GetUsersInfo retrieves the users with the given IDs, asking for many users
+// per call. The users found are returned; the IDs of the calls that failed
+// are reported in the *BatchError, whose errors are in the order of userIDs.
+func (b *Batch) GetUsersInfo(ctx context.Context, userIDs ...string) ([]User, error) {
+	chunks := slices.Collect(slices.Chunk(userIDs, batchUsersInfoChunk))
+	found := make([][]User, len(chunks))
+	err := b.Run(ctx, len(chunks), func(ctx context.Context, i int) error {
+		users, err := b.api.GetUsersInfoContext(ctx, chunks[i]...)
+		if err != nil {
+			return err
+		}
+		found[i] = *users
+		return nil
+	})
+
+	var users []User
+	for _, chunk := range found {
+		users = append(users, chunk...)
+	}
+	if batchErr, ok := errors.AsType[*BatchError](err); ok {
+		// Report the error of each chunk for each of its users.
+		errs := make([]error, 0, len(userIDs))
+		for i, chunk := range chunks {
+			for range chunk {
+				errs = append(errs, batchErr.Errors[i])
+			}
+		}
+		err = &BatchError{Errors: errs}
+	}
+	return users, err
+}
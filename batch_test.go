@@ -0,0 +1,293 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchRunConcurrency(t *testing.T) {
+	var inflight, peak atomic.Int32
+	results := make([]int, 20)
+
+	err := New("xoxb-test").NewBatch(BatchOptionConcurrency(3)).Run(context.Background(), len(results), func(ctx context.Context, i int) error {
+		n := inflight.Add(1)
+		defer inflight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		results[i] = i * i
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), peak.Load())
+	for i, result := range results {
+		assert.Equal(t, i*i, result)
+	}
+}
+
+func TestBatchRunPartialFailure(t *testing.T) {
+	var calls sync.Map
+	b := New("xoxb-test").NewBatch(BatchOptionMaxAttempts(3), BatchOptionBackoff(time.Millisecond, time.Millisecond))
+
+	err := b.Run(context.Background(), 4, func(ctx context.Context, i int) error {
+		n, _ := calls.LoadOrStore(i, new(atomic.Int32))
+		attempt := n.(*atomic.Int32).Add(1)
+		switch i {
+		case 1:
+			return SlackErrorResponse{Err: "channel_not_found"}
+		case 2:
+			if attempt < 3 {
+				return SlackErrorResponse{Err: "internal_error"}
+			}
+		case 3:
+			return SlackErrorResponse{Err: "service_unavailable"}
+		}
+		return nil
+	})
+
+	var batchErr *BatchError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, []int{1, 3}, batchErr.Failed())
+	assert.Len(t, batchErr.Errors, 4)
+	assert.ErrorIs(t, err, ErrChannelNotFound)
+	assert.ErrorIs(t, err, ErrServiceUnavailable)
+	assert.EqualError(t, err, "slack: 2 of 4 calls failed, first: channel_not_found")
+
+	attempts := func(i int) int32 {
+		n, _ := calls.Load(i)
+		return n.(*atomic.Int32).Load()
+	}
+	assert.Equal(t, int32(1), attempts(1), "not found errors are not retried")
+	assert.Equal(t, int32(3), attempts(2))
+	assert.Equal(t, int32(3), attempts(3))
+}
+
+func TestBatchRunRateLimited(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		limited bool
+		starts  []time.Time
+	)
+	b := New("xoxb-test").NewBatch(BatchOptionConcurrency(2), BatchOptionMaxAttempts(1))
+
+	start := time.Now()
+	err := b.Run(context.Background(), 4, func(ctx context.Context, i int) error {
+		mu.Lock()
+		starts = append(starts, time.Now())
+		first := !limited
+		limited = true
+		mu.Unlock()
+		if first {
+			return &RateLimitedError{RetryAfter: 100 * time.Millisecond}
+		}
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+
+	require.NoError(t, err, "rate limited attempts do not count toward the maximum")
+	require.Len(t, starts, 5)
+	for _, at := range starts[2:] {
+		assert.GreaterOrEqual(t, at.Sub(start), 100*time.Millisecond, "calls wait for the rate limit to pass")
+	}
+}
+
+func TestBatchRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+
+	err := New("xoxb-test").NewBatch(BatchOptionConcurrency(1)).Run(ctx, 5, func(ctx context.Context, i int) error {
+		if calls.Add(1) == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	var batchErr *BatchError
+	require.ErrorAs(t, err, &batchErr)
+	assert.NoError(t, batchErr.Errors[0])
+	assert.NoError(t, batchErr.Errors[1])
+	assert.Equal(t, []int{2, 3, 4}, batchErr.Failed())
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestBatchPostUserGroupDirectMessages(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		posts = map[string]string{}
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/usergroups.users.list", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "S1", r.FormValue("usergroup"))
+		rw.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(rw).Encode(map[string]any{"ok": true, "users": []string{"U1", "U2", "U3"}})
+	})
+	mux.HandleFunc("/conversations.open", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("users") == "U2" {
+			json.NewEncoder(rw).Encode(map[string]any{"ok": false, "error": "user_not_found"})
+			return
+		}
+		json.NewEncoder(rw).Encode(map[string]any{"ok": true, "channel": map[string]any{"id": "D" + r.FormValue("users")}})
+	})
+	mux.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		posts[r.FormValue("channel")] = r.FormValue("text")
+		mu.Unlock()
+		rw.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(rw).Encode(map[string]any{"ok": true, "channel": r.FormValue("channel"), "ts": "1700000000.000001"})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	api := New("xoxb-test", OptionAPIURL(server.URL+"/"))
+
+	members, messages, err := api.NewBatch().PostUserGroupDirectMessages(context.Background(), "S1", MsgOptionText("hi", false))
+
+	assert.Equal(t, []string{"U1", "U2", "U3"}, members)
+	assert.Equal(t, []BatchMessage{
+		{Channel: "DU1", Timestamp: "1700000000.000001"},
+		{},
+		{Channel: "DU3", Timestamp: "1700000000.000001"},
+	}, messages)
+	var batchErr *BatchError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, []int{1}, batchErr.Failed())
+	assert.ErrorIs(t, err, ErrUserNotFound)
+	assert.Equal(t, map[string]string{"DU1": "hi", "DU3": "hi"}, posts)
+}
+
+func TestBatchPostDirectMessagesRetries(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		opens = map[string]int{}
+		posts = map[string]int{}
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/conversations.open", func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		opens[r.FormValue("users")]++
+		mu.Unlock()
+		rw.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(rw).Encode(map[string]any{"ok": true, "channel": map[string]any{"id": "D" + r.FormValue("users")}})
+	})
+	mux.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		channel := r.FormValue("channel")
+		mu.Lock()
+		posts[channel]++
+		attempt := posts[channel]
+		mu.Unlock()
+		switch {
+		case channel == "DU1" && attempt == 1:
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		case channel == "DU2":
+			// The message may or may not have been posted.
+			rw.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(rw).Encode(map[string]any{"ok": true, "channel": channel, "ts": "1700000000.000001"})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	api := New("xoxb-test", OptionAPIURL(server.URL+"/"))
+
+	messages, err := api.NewBatch(BatchOptionBackoff(time.Millisecond, time.Millisecond)).PostDirectMessages(context.Background(), []string{"U1", "U2"}, MsgOptionText("hi", false))
+
+	assert.Equal(t, []BatchMessage{{Channel: "DU1", Timestamp: "1700000000.000001"}, {}}, messages)
+	var batchErr *BatchError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, []int{1}, batchErr.Failed())
+	assert.IsType(t, StatusCodeError{}, batchErr.Errors[1])
+	assert.Equal(t, map[string]int{"U1": 1, "U2": 1}, opens, "conversations are opened once")
+	assert.Equal(t, map[string]int{"DU1": 2, "DU2": 1}, posts, "only rate limited posts are retried")
+}
+
+func TestBatchAddReactions(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		reactions []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/reactions.add", r.URL.Path)
+		assert.Equal(t, "tada", r.FormValue("name"))
+		mu.Lock()
+		reactions = append(reactions, r.FormValue("timestamp"))
+		mu.Unlock()
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("timestamp") == "2" {
+			json.NewEncoder(rw).Encode(map[string]any{"ok": false, "error": "already_reacted"})
+			return
+		}
+		json.NewEncoder(rw).Encode(map[string]any{"ok": true})
+	}))
+	defer server.Close()
+	api := New("xoxb-test", OptionAPIURL(server.URL+"/"))
+
+	err := api.NewBatch().AddReactions(context.Background(), "tada", []ItemRef{
+		NewRefToMessage("C1", "1"), NewRefToMessage("C1", "2"), NewRefToMessage("C1", "3"),
+	})
+
+	assert.ErrorIs(t, err, ErrAlreadyReacted)
+	assert.ElementsMatch(t, []string{"1", "2", "3"}, reactions)
+}
+
+func TestBatchGetUsersInfo(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users.info", r.URL.Path)
+		calls.Add(1)
+		ids := strings.Split(r.FormValue("users"), ",")
+		assert.LessOrEqual(t, len(ids), batchUsersInfoChunk)
+
+		rw.Header().Set("Content-Type", "application/json")
+		if ids[0] == "U100" {
+			json.NewEncoder(rw).Encode(map[string]any{"ok": false, "error": "users_not_found"})
+			return
+		}
+		var users []User
+		for _, id := range ids {
+			users = append(users, User{ID: id})
+		}
+		json.NewEncoder(rw).Encode(map[string]any{"ok": true, "users": users})
+	}))
+	defer server.Close()
+	api := New("xoxb-test", OptionAPIURL(server.URL+"/"))
+
+	ids := make([]string, 250)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("U%d", i)
+	}
+	users, err := api.NewBatch().GetUsersInfo(context.Background(), ids...)
+
+	assert.Equal(t, int32(3), calls.Load())
+	require.Len(t, users, 150)
+	assert.Equal(t, "U0", users[0].ID)
+	assert.Equal(t, "U249", users[149].ID)
+
+	var batchErr *BatchError
+	require.ErrorAs(t, err, &batchErr)
+	require.Len(t, batchErr.Errors, 250)
+	failed := batchErr.Failed()
+	assert.Len(t, failed, 100)
+	assert.Equal(t, 100, failed[0])
+	assert.Equal(t, 199, failed[99])
+	assert.ErrorIs(t, err, ErrUsersNotFound)
+}
@@ -7,9 +7,10 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"regexp"
 	"strconv"
+	"strings"
 
+	"github.com/slack-go/slack/internal/redact"
 	"github.com/slack-go/slack/slackutilsx"
 )
 
@@ -288,41 +289,64 @@ func (api *Client) SendMessageContext(ctx context.Context, channelID string, opt
 // sendResponseFull sends a message and returns the full response.
 // It returns a nil response if the request could not be built or sent;
 // otherwise the returned error is the response's error, if any.
+//
+// Messages posted with MsgOptionIdempotencyKey are posted at most once.
 func (api *Client) sendResponseFull(ctx context.Context, channelID string, options ...MsgOption) (*chatResponseFull, error) {
-	var (
-		req      *http.Request
-		parser   func(*chatResponseFull) responseParser
-		response chatResponseFull
-		err      error
-	)
+	config, err := applyMsgOptions(api.token, channelID, api.endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+	if config.idempotencyKey != "" && config.endpoint == api.endpoint+string(chatPostMessage) {
+		return api.sendIdempotent(ctx, channelID, config, options...)
+	}
+	return api.sendResponseOnce(ctx, channelID, options...)
+}
 
-	if req, parser, err = buildSender(api.endpoint, options...).BuildRequestContext(ctx, api.token, channelID); err != nil {
+// sendResponseOnce sends a message as sendResponseFull does, without
+// idempotency.
+func (api *Client) sendResponseOnce(ctx context.Context, channelID string, options ...MsgOption) (*chatResponseFull, error) {
+	config, err := applyMsgOptions("", channelID, api.endpoint, options...)
+	if err != nil {
 		return nil, err
 	}
 
-	if api.Debug() {
-		reqBody, err := io.ReadAll(req.Body)
+	var response chatResponseFull
+	err = api.doWithToken(ctx, sentMethod(api.endpoint, config.endpoint), url.Values{"channel": {channelID}}, &response, func(ctx context.Context, token string) (http.Header, error) {
+		req, parser, err := buildSender(api.endpoint, options...).BuildRequestContext(ctx, token, channelID)
 		if err != nil {
 			return nil, err
 		}
-		req.Body = io.NopCloser(bytes.NewBuffer(reqBody))
-		api.Debugf("Sending request: %s", redactToken(reqBody))
-	}
 
-	if _, err = doPost(api.httpclient, req, parser(&response), api); err != nil {
+		if api.Debug() {
+			reqBody, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			req.Body = io.NopCloser(bytes.NewBuffer(reqBody))
+			api.Debugf("Sending request: %s", redactToken(reqBody))
+		}
+
+		return doPost(api.httpclient, req, parser(&response), api)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return &response, response.Err()
 }
 
+// sentMethod returns the API method a message is sent to with target, or
+// "response_url" when it is sent to a response URL.
+func sentMethod(endpoint, target string) string {
+	if method, ok := strings.CutPrefix(target, endpoint); ok {
+		return method
+	}
+	return "response_url"
+}
+
+// redactToken redacts the tokens and connection tickets in b.
 func redactToken(b []byte) []byte {
-	// See https://api.slack.com/authentication/token-types
-	// and https://api.slack.com/authentication/rotation
-	re := regexp.MustCompile(`(token=x[a-z.]+)-[0-9A-Za-z-]+`)
-	// Keep "token=" and the first element of the token, which identifies its type
-	// (this could be useful for debugging, e.g. when using a wrong token).
-	return re.ReplaceAll(b, []byte("$1-REDACTED"))
+	return redact.Bytes(b)
 }
 
 // UnsafeApplyMsgOptions utility function for debugging/testing chat requests.
@@ -349,6 +373,12 @@ func applyMsgOptions(token, channel, apiurl string, options ...MsgOption) (sendC
 		}
 	}
 
+	if config.idempotencyKey != "" {
+		if err := config.tagIdempotencyKey(); err != nil {
+			return config, err
+		}
+	}
+
 	return config, nil
 }
 
@@ -383,6 +413,7 @@ type sendConfig struct {
 	values          url.Values
 	attachments     []Attachment
 	metadata        SlackMetadata
+	idempotencyKey  string
 	blocks          Blocks
 	responseType    string
 	replaceOriginal bool
@@ -1075,7 +1106,7 @@ func (api *Client) GetPermalinkContext(ctx context.Context, params *PermalinkPar
 		Permalink string `json:"permalink"`
 		SlackResponse
 	}{}
-	err := api.getMethod(ctx, "chat.getPermalink", api.token, values, &response)
+	err := api.getMethod(ctx, "chat.getPermalink", values, &response)
 	if err != nil {
 		return "", err
 	}
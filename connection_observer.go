@@ -0,0 +1,67 @@
+package slack
+
+import "time"
+
+// ConnectionType tells the WebSocket clients reporting to a
+// ConnectionObserver apart.
+type ConnectionType string
+
+const (
+	ConnectionTypeRTM        ConnectionType = "rtm"
+	ConnectionTypeSocketMode ConnectionType = "socketmode"
+)
+
+// Reasons passed to ConnectionObserver.Reconnecting.
+const (
+	ReconnectReasonDisconnectRequested = "disconnect_requested"
+	ReconnectReasonGoodbye             = "goodbye"
+	ReconnectReasonPingTimeout         = "ping_timeout"
+	ReconnectReasonConnectionError     = "connection_error"
+)
+
+// Reasons passed to ConnectionObserver.ResponseDropped.
+const (
+	DropReasonOversized   = "oversized"
+	DropReasonWriteFailed = "write_failed"
+)
+
+// ConnectionObserver receives measurements from the RTM and Socket Mode
+// clients, to be exported as metrics or traces. Set it with
+// RTMOptionObserver or socketmode.OptionObserver; the otelslack package
+// provides an OpenTelemetry implementation.
+//
+// Methods are called synchronously from the connection goroutines, so they
+// must be safe for concurrent use and return quickly. Embed
+// NopConnectionObserver to implement only some of them.
+type ConnectionObserver interface {
+	// ConnectAttempt is called after every attempt to open a connection,
+	// with a nil err on success.
+	ConnectAttempt(connection ConnectionType, attempt int, err error)
+	// Reconnecting is called when a connection is replaced, with one of the
+	// ReconnectReason constants.
+	Reconnecting(connection ConnectionType, reason string)
+	// PingLatency reports the round trip of a ping sent by the client.
+	PingLatency(connection ConnectionType, latency time.Duration)
+	// EventReceived is called for every message read from the connection.
+	EventReceived(connection ConnectionType, eventType string)
+	// Acked reports the time between receiving a Socket Mode envelope and
+	// writing its acknowledgement, or between sending an RTM message and
+	// Slack acknowledging it.
+	Acked(connection ConnectionType, eventType string, latency time.Duration)
+	// HandlerDone reports how long an event handler ran.
+	HandlerDone(connection ConnectionType, eventType string, duration time.Duration)
+	// ResponseDropped is called when a response or outgoing message could
+	// not be sent, with one of the DropReason constants.
+	ResponseDropped(connection ConnectionType, reason string)
+}
+
+// NopConnectionObserver is a ConnectionObserver that ignores everything.
+type NopConnectionObserver struct{}
+
+func (NopConnectionObserver) ConnectAttempt(ConnectionType, int, error)         {}
+func (NopConnectionObserver) Reconnecting(ConnectionType, string)               {}
+func (NopConnectionObserver) PingLatency(ConnectionType, time.Duration)         {}
+func (NopConnectionObserver) EventReceived(ConnectionType, string)              {}
+func (NopConnectionObserver) Acked(ConnectionType, string, time.Duration)       {}
+func (NopConnectionObserver) HandlerDone(ConnectionType, string, time.Duration) {}
+func (NopConnectionObserver) ResponseDropped(ConnectionType, string)            {}
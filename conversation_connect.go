@@ -0,0 +1,416 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// AcceptSharedInviteParams defines the parameters for the AcceptSharedInvite and AcceptSharedInviteContext functions.
+// Either InviteID or ChannelID must be set.
+type AcceptSharedInviteParams struct {
+	ChannelName       string
+	InviteID          string
+	ChannelID         string
+	IsPrivate         bool
+	TeamID            string
+	FreeTrialAccepted bool
+}
+
+// AcceptSharedInviteResponse is the response of conversations.acceptSharedInvite.
+type AcceptSharedInviteResponse struct {
+	ChannelID        string `json:"channel_id"`
+	InviteID         string `json:"invite_id"`
+	ImplicitApproval bool   `json:"implicit_approval"`
+}
+
+// AcceptSharedInvite accepts an invitation to a Slack Connect channel.
+// For more details, see AcceptSharedInviteContext documentation.
+func (api *Client) AcceptSharedInvite(params AcceptSharedInviteParams) (*AcceptSharedInviteResponse, error) {
+	return api.AcceptSharedInviteContext(context.Background(), params)
+}
+
+// AcceptSharedInviteContext accepts an invitation to a Slack Connect channel with a custom context.
+// Slack API docs: https://api.slack.com/methods/conversations.acceptSharedInvite
+func (api *Client) AcceptSharedInviteContext(ctx context.Context, params AcceptSharedInviteParams) (*AcceptSharedInviteResponse, error) {
+	values := url.Values{
+		"token":        {api.token},
+		"channel_name": {params.ChannelName},
+	}
+	if params.InviteID != "" {
+		values.Add("invite_id", params.InviteID)
+	}
+	if params.ChannelID != "" {
+		values.Add("channel_id", params.ChannelID)
+	}
+	if params.IsPrivate {
+		values.Add("is_private", "true")
+	}
+	if params.TeamID != "" {
+		values.Add("team_id", params.TeamID)
+	}
+	if params.FreeTrialAccepted {
+		values.Add("free_trial_accepted", "true")
+	}
+
+	response := struct {
+		SlackResponse
+		AcceptSharedInviteResponse
+	}{}
+
+	err := api.postMethod(ctx, "conversations.acceptSharedInvite", values, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.AcceptSharedInviteResponse, response.Err()
+}
+
+// ApproveSharedInvite approves an invitation to a Slack Connect channel.
+// For more details, see ApproveSharedInviteContext documentation.
+func (api *Client) ApproveSharedInvite(inviteID, targetTeam string) error {
+	return api.ApproveSharedInviteContext(context.Background(), inviteID, targetTeam)
+}
+
+// ApproveSharedInviteContext approves an invitation to a Slack Connect channel with a custom context.
+// targetTeam is optional and only needed when the invite is sent to several organizations.
+// Slack API docs: https://api.slack.com/methods/conversations.approveSharedInvite
+func (api *Client) ApproveSharedInviteContext(ctx context.Context, inviteID, targetTeam string) error {
+	return api.sharedInviteDecision(ctx, "conversations.approveSharedInvite", inviteID, targetTeam)
+}
+
+// DeclineSharedInvite declines a Slack Connect channel invite.
+// For more details, see DeclineSharedInviteContext documentation.
+func (api *Client) DeclineSharedInvite(inviteID, targetTeam string) error {
+	return api.DeclineSharedInviteContext(context.Background(), inviteID, targetTeam)
+}
+
+// DeclineSharedInviteContext declines a Slack Connect channel invite with a custom context.
+// targetTeam is optional and only needed when the invite is sent to several organizations.
+// Slack API docs: https://api.slack.com/methods/conversations.declineSharedInvite
+func (api *Client) DeclineSharedInviteContext(ctx context.Context, inviteID, targetTeam string) error {
+	return api.sharedInviteDecision(ctx, "conversations.declineSharedInvite", inviteID, targetTeam)
+}
+
+func (api *Client) sharedInviteDecision(ctx context.Context, path, inviteID, targetTeam string) error {
+	values := url.Values{
+		"token":     {api.token},
+		"invite_id": {inviteID},
+	}
+	if targetTeam != "" {
+		values.Add("target_team", targetTeam)
+	}
+
+	response := SlackResponse{}
+	err := api.postMethod(ctx, path, values, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// ConnectInviteTeam is a workspace or organization taking part in a Slack Connect invite.
+type ConnectInviteTeam struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Domain        string `json:"domain"`
+	IsVerified    bool   `json:"is_verified"`
+	AvatarBaseURL string `json:"avatar_base_url,omitempty"`
+	DateCreated   int64  `json:"date_created,omitempty"`
+}
+
+// ConnectInviteUser is a user taking part in a Slack Connect invite.
+type ConnectInviteUser struct {
+	ID      string       `json:"id"`
+	TeamID  string       `json:"team_id"`
+	Name    string       `json:"name"`
+	Updated int64        `json:"updated,omitempty"`
+	Profile *UserProfile `json:"profile,omitempty"`
+}
+
+// ConnectInviteDetails holds the details of the invitation itself.
+type ConnectInviteDetails struct {
+	ID              string             `json:"id"`
+	DateCreated     int64              `json:"date_created"`
+	DateInvalid     int64              `json:"date_invalid"`
+	InvitingTeam    *ConnectInviteTeam `json:"inviting_team,omitempty"`
+	InvitingUser    *ConnectInviteUser `json:"inviting_user,omitempty"`
+	RecipientEmail  string             `json:"recipient_email,omitempty"`
+	RecipientUserID string             `json:"recipient_user_id,omitempty"`
+	Link            string             `json:"link,omitempty"`
+}
+
+// ConnectInviteChannel is the channel a Slack Connect invite is for.
+type ConnectInviteChannel struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IsPrivate bool   `json:"is_private"`
+	IsIM      bool   `json:"is_im"`
+}
+
+// ConnectInviteReview is an approval or denial of an accepted invite.
+type ConnectInviteReview struct {
+	Type          string             `json:"type"`
+	ReviewingTeam *ConnectInviteTeam `json:"reviewing_team,omitempty"`
+	DateReview    int64              `json:"date_review"`
+}
+
+// ConnectInviteAcceptance records an organization accepting an invite.
+type ConnectInviteAcceptance struct {
+	ApprovalStatus  string                `json:"approval_status"`
+	DateAccepted    int64                 `json:"date_accepted"`
+	DateInvalid     int64                 `json:"date_invalid"`
+	DateLastUpdated int64                 `json:"date_last_updated"`
+	AcceptingTeam   *ConnectInviteTeam    `json:"accepting_team,omitempty"`
+	AcceptingUser   *ConnectInviteUser    `json:"accepting_user,omitempty"`
+	Reviews         []ConnectInviteReview `json:"reviews,omitempty"`
+}
+
+// ConnectInvite is a Slack Connect invite returned by conversations.listConnectInvites.
+type ConnectInvite struct {
+	ID              string                    `json:"id"`
+	Direction       string                    `json:"direction"`
+	Status          string                    `json:"status"`
+	DateLastUpdated int64                     `json:"date_last_updated"`
+	InviteType      string                    `json:"invite_type"`
+	Invite          ConnectInviteDetails      `json:"invite"`
+	Channel         ConnectInviteChannel      `json:"channel"`
+	Acceptances     []ConnectInviteAcceptance `json:"acceptances,omitempty"`
+}
+
+// ListConnectInvitesParams defines the parameters for the ListConnectInvites and ListConnectInvitesContext functions.
+type ListConnectInvitesParams struct {
+	Count  int
+	Cursor string
+	TeamID string
+}
+
+// ListConnectInvites lists shared channel invites that have been generated or received but have not been approved by all parties.
+// For more details, see ListConnectInvitesContext documentation.
+func (api *Client) ListConnectInvites(params ListConnectInvitesParams) ([]ConnectInvite, string, error) {
+	return api.ListConnectInvitesContext(context.Background(), params)
+}
+
+// ListConnectInvitesContext lists shared channel invites that have been generated or received but have not been approved by all parties,
+// with a custom context. It returns the invites and the cursor of the next page.
+// Slack API docs: https://api.slack.com/methods/conversations.listConnectInvites
+func (api *Client) ListConnectInvitesContext(ctx context.Context, params ListConnectInvitesParams) ([]ConnectInvite, string, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+	if params.Count > 0 {
+		values.Add("count", strconv.Itoa(params.Count))
+	}
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+	if params.TeamID != "" {
+		values.Add("team_id", params.TeamID)
+	}
+
+	response := struct {
+		SlackResponse
+		Invites []ConnectInvite `json:"invites"`
+	}{}
+
+	err := api.postMethod(ctx, "conversations.listConnectInvites", values, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response.Invites, response.ResponseMetadata.Cursor, response.Err()
+}
+
+// SharedInviteRequestMessage is a message sent to the requester when an invite request is approved or denied.
+type SharedInviteRequestMessage struct {
+	Text       string `json:"text"`
+	IsOverride bool   `json:"is_override,omitempty"`
+}
+
+// ApproveSharedInviteRequestParams defines the parameters for the ApproveSharedInviteRequest and ApproveSharedInviteRequestContext functions.
+type ApproveSharedInviteRequestParams struct {
+	InviteID          string
+	ChannelID         string
+	IsExternalLimited *bool
+	Message           *SharedInviteRequestMessage
+}
+
+// ApproveSharedInviteRequest approves a request to add an external user to a channel and sends them a Slack Connect invite.
+// For more details, see ApproveSharedInviteRequestContext documentation.
+func (api *Client) ApproveSharedInviteRequest(params ApproveSharedInviteRequestParams) error {
+	return api.ApproveSharedInviteRequestContext(context.Background(), params)
+}
+
+// ApproveSharedInviteRequestContext approves a request to add an external user to a channel and sends them a Slack Connect invite,
+// with a custom context.
+// Slack API docs: https://api.slack.com/methods/conversations.requestSharedInvite.approve
+func (api *Client) ApproveSharedInviteRequestContext(ctx context.Context, params ApproveSharedInviteRequestParams) error {
+	values := url.Values{
+		"token":     {api.token},
+		"invite_id": {params.InviteID},
+	}
+	if params.ChannelID != "" {
+		values.Add("channel_id", params.ChannelID)
+	}
+	if params.IsExternalLimited != nil {
+		values.Add("is_external_limited", strconv.FormatBool(*params.IsExternalLimited))
+	}
+	if params.Message != nil {
+		message, err := json.Marshal(params.Message)
+		if err != nil {
+			return err
+		}
+		values.Add("message", string(message))
+	}
+
+	response := SlackResponse{}
+	err := api.postMethod(ctx, "conversations.requestSharedInvite.approve", values, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// DenySharedInviteRequest denies a request to invite an external user to a channel.
+// For more details, see DenySharedInviteRequestContext documentation.
+func (api *Client) DenySharedInviteRequest(inviteID, message string) error {
+	return api.DenySharedInviteRequestContext(context.Background(), inviteID, message)
+}
+
+// DenySharedInviteRequestContext denies a request to invite an external user to a channel with a custom context.
+// message is optional and is sent to the requesting user.
+// Slack API docs: https://api.slack.com/methods/conversations.requestSharedInvite.deny
+func (api *Client) DenySharedInviteRequestContext(ctx context.Context, inviteID, message string) error {
+	values := url.Values{
+		"token":     {api.token},
+		"invite_id": {inviteID},
+	}
+	if message != "" {
+		values.Add("message", message)
+	}
+
+	response := SlackResponse{}
+	err := api.postMethod(ctx, "conversations.requestSharedInvite.deny", values, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// SharedInviteRequestTarget is an external user an invite request was made for.
+type SharedInviteRequestTarget struct {
+	RecipientEmail  string `json:"recipient_email,omitempty"`
+	RecipientUserID string `json:"recipient_user_id,omitempty"`
+}
+
+// SharedInviteRequest is a request from a user to invite external users to a channel.
+type SharedInviteRequest struct {
+	ID                string                      `json:"id"`
+	DateCreated       int64                       `json:"date_created"`
+	DateExpire        int64                       `json:"date_expire"`
+	Channel           ConnectInviteChannel        `json:"channel"`
+	InvitingUser      *ConnectInviteUser          `json:"inviting_user,omitempty"`
+	TargetUsers       []SharedInviteRequestTarget `json:"target_users,omitempty"`
+	Team              *ConnectInviteTeam          `json:"team,omitempty"`
+	IsExternalLimited bool                        `json:"is_external_limited"`
+}
+
+// ListSharedInviteRequestsParams defines the parameters for the ListSharedInviteRequests and ListSharedInviteRequestsContext functions.
+type ListSharedInviteRequestsParams struct {
+	Cursor          string
+	Limit           int
+	UserID          string
+	InviteIDs       []string
+	IncludeApproved bool
+	IncludeDenied   bool
+	IncludeExpired  bool
+}
+
+// ListSharedInviteRequests lists requests to add external users to channels.
+// For more details, see ListSharedInviteRequestsContext documentation.
+func (api *Client) ListSharedInviteRequests(params ListSharedInviteRequestsParams) ([]SharedInviteRequest, string, error) {
+	return api.ListSharedInviteRequestsContext(context.Background(), params)
+}
+
+// ListSharedInviteRequestsContext lists requests to add external users to channels with a custom context.
+// It returns the requests and the cursor of the next page.
+// Slack API docs: https://api.slack.com/methods/conversations.requestSharedInvite.list
+func (api *Client) ListSharedInviteRequestsContext(ctx context.Context, params ListSharedInviteRequestsParams) ([]SharedInviteRequest, string, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		values.Add("limit", strconv.Itoa(params.Limit))
+	}
+	if params.UserID != "" {
+		values.Add("user_id", params.UserID)
+	}
+	if len(params.InviteIDs) > 0 {
+		values.Add("invite_ids", strings.Join(params.InviteIDs, ","))
+	}
+	if params.IncludeApproved {
+		values.Add("include_approved", "true")
+	}
+	if params.IncludeDenied {
+		values.Add("include_denied", "true")
+	}
+	if params.IncludeExpired {
+		values.Add("include_expired", "true")
+	}
+
+	response := struct {
+		SlackResponse
+		InviteRequests []SharedInviteRequest `json:"invite_requests"`
+	}{}
+
+	err := api.postMethod(ctx, "conversations.requestSharedInvite.list", values, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response.InviteRequests, response.ResponseMetadata.Cursor, response.Err()
+}
+
+// ExternalInvitePermissionsAction is the change applied by SetExternalInvitePermissions.
+type ExternalInvitePermissionsAction string
+
+const (
+	// ExternalInvitePermissionsUpgrade gives an external organization full posting permissions.
+	ExternalInvitePermissionsUpgrade ExternalInvitePermissionsAction = "upgrade"
+	// ExternalInvitePermissionsDowngrade limits an external organization to posting only.
+	ExternalInvitePermissionsDowngrade ExternalInvitePermissionsAction = "downgrade"
+)
+
+// SetExternalInvitePermissions upgrades or downgrades the permissions of an external organization in a Slack Connect channel.
+// For more details, see SetExternalInvitePermissionsContext documentation.
+func (api *Client) SetExternalInvitePermissions(channelID, targetTeam string, action ExternalInvitePermissionsAction) error {
+	return api.SetExternalInvitePermissionsContext(context.Background(), channelID, targetTeam, action)
+}
+
+// SetExternalInvitePermissionsContext upgrades or downgrades the permissions of an external organization in a Slack Connect channel
+// with a custom context.
+// Slack API docs: https://api.slack.com/methods/conversations.externalInvitePermissions.set
+func (api *Client) SetExternalInvitePermissionsContext(ctx context.Context, channelID, targetTeam string, action ExternalInvitePermissionsAction) error {
+	values := url.Values{
+		"token":       {api.token},
+		"channel":     {channelID},
+		"target_team": {targetTeam},
+		"action":      {string(action)},
+	}
+
+	response := SlackResponse{}
+	err := api.postMethod(ctx, "conversations.externalInvitePermissions.set", values, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
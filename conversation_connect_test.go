@@ -0,0 +1,181 @@
+package slack
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptSharedInvite(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/conversations.acceptSharedInvite", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "partners", r.FormValue("channel_name"))
+		assert.Equal(t, "I123", r.FormValue("invite_id"))
+		assert.Equal(t, "true", r.FormValue("is_private"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel_id": "C123", "invite_id": "I123", "implicit_approval": true}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	resp, err := api.AcceptSharedInvite(AcceptSharedInviteParams{
+		ChannelName: "partners",
+		InviteID:    "I123",
+		IsPrivate:   true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &AcceptSharedInviteResponse{ChannelID: "C123", InviteID: "I123", ImplicitApproval: true}, resp)
+}
+
+func TestApproveAndDeclineSharedInvite(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	handler := func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "I123", r.FormValue("invite_id"))
+		assert.Equal(t, "T999", r.FormValue("target_team"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	}
+	http.HandleFunc("/conversations.approveSharedInvite", handler)
+	http.HandleFunc("/conversations.declineSharedInvite", handler)
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	require.NoError(t, api.ApproveSharedInvite("I123", "T999"))
+	require.NoError(t, api.DeclineSharedInvite("I123", "T999"))
+}
+
+func TestListConnectInvites(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/conversations.listConnectInvites", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "10", r.FormValue("count"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{
+			"ok": true,
+			"invites": [{
+				"id": "I02UKAJ6RJA",
+				"direction": "outgoing",
+				"status": "approved",
+				"date_last_updated": 1644346468,
+				"invite_type": "channel",
+				"invite": {
+					"id": "I02UKAJ6RJA",
+					"date_created": 1644346446,
+					"date_invalid": 1645556046,
+					"inviting_team": {"id": "E12345678", "name": "Acme", "domain": "acme", "is_verified": false},
+					"inviting_user": {"id": "U12345678", "team_id": "E12345678", "name": "primary-owner"},
+					"recipient_email": "golden@doodle.com",
+					"recipient_user_id": "U87654321"
+				},
+				"channel": {"id": "C12345678", "is_private": false, "is_im": false, "name": "test-slack-connect"},
+				"acceptances": [{
+					"approval_status": "approved",
+					"date_accepted": 1644346468,
+					"accepting_team": {"id": "E98765432", "name": "Golden", "domain": "golden", "is_verified": false},
+					"reviews": [{"type": "approve", "date_review": 1644346468, "reviewing_team": {"id": "E98765432"}}]
+				}]
+			}],
+			"response_metadata": {"next_cursor": "next"}
+		}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	invites, cursor, err := api.ListConnectInvites(ListConnectInvitesParams{Count: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "next", cursor)
+	require.Len(t, invites, 1)
+	invite := invites[0]
+	assert.Equal(t, "outgoing", invite.Direction)
+	assert.Equal(t, "Acme", invite.Invite.InvitingTeam.Name)
+	assert.Equal(t, "U87654321", invite.Invite.RecipientUserID)
+	assert.Equal(t, "test-slack-connect", invite.Channel.Name)
+	require.Len(t, invite.Acceptances, 1)
+	assert.Equal(t, "approve", invite.Acceptances[0].Reviews[0].Type)
+}
+
+func TestApproveSharedInviteRequest(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/conversations.requestSharedInvite.approve", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "I123", r.FormValue("invite_id"))
+		assert.Equal(t, "true", r.FormValue("is_external_limited"))
+		assert.JSONEq(t, `{"text": "Welcome!", "is_override": true}`, r.FormValue("message"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	limited := true
+	err := api.ApproveSharedInviteRequest(ApproveSharedInviteRequestParams{
+		InviteID:          "I123",
+		IsExternalLimited: &limited,
+		Message:           &SharedInviteRequestMessage{Text: "Welcome!", IsOverride: true},
+	})
+	require.NoError(t, err)
+}
+
+func TestDenySharedInviteRequest(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/conversations.requestSharedInvite.deny", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "no external guests here", r.FormValue("message"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": false, "error": "invite_not_found"}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	err := api.DenySharedInviteRequest("I123", "no external guests here")
+	require.EqualError(t, err, "invite_not_found")
+}
+
+func TestListSharedInviteRequests(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/conversations.requestSharedInvite.list", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "I1,I2", r.FormValue("invite_ids"))
+		assert.Equal(t, "true", r.FormValue("include_denied"))
+		assert.Empty(t, r.FormValue("include_approved"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{
+			"ok": true,
+			"invite_requests": [{
+				"id": "I1",
+				"date_created": 1700000000,
+				"date_expire": 1701000000,
+				"channel": {"id": "C1", "name": "partners", "is_private": true},
+				"inviting_user": {"id": "U1", "team_id": "T1", "name": "requester"},
+				"target_users": [{"recipient_email": "ext@example.com"}],
+				"is_external_limited": true
+			}]
+		}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	requests, cursor, err := api.ListSharedInviteRequests(ListSharedInviteRequestsParams{
+		InviteIDs:     []string{"I1", "I2"},
+		IncludeDenied: true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, cursor)
+	require.Len(t, requests, 1)
+	assert.True(t, requests[0].Channel.IsPrivate)
+	assert.Equal(t, "ext@example.com", requests[0].TargetUsers[0].RecipientEmail)
+	assert.Equal(t, "requester", requests[0].InvitingUser.Name)
+}
+
+func TestSetExternalInvitePermissions(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/conversations.externalInvitePermissions.set", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "C123", r.FormValue("channel"))
+		assert.Equal(t, "T999", r.FormValue("target_team"))
+		assert.Equal(t, "downgrade", r.FormValue("action"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	require.NoError(t, api.SetExternalInvitePermissions("C123", "T999", ExternalInvitePermissionsDowngrade))
+}
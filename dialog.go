@@ -108,7 +108,7 @@ func (api *Client) OpenDialogContext(ctx context.Context, triggerID string, dial
 	}
 
 	response := &DialogOpenResponse{}
-	if err := api.postJSONMethod(ctx, "dialog.open", api.token, encoded, response); err != nil {
+	if err := api.postJSONMethod(ctx, "dialog.open", encoded, response); err != nil {
 		return err
 	}
 
@@ -0,0 +1,126 @@
+package slack
+
+//go:generate go run ./internal/errorcodegen -in internal/errorcodegen/error_codes.csv -out error_codes_gen.go
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// ErrorCode is an error code returned by the Web API, e.g.
+// "channel_not_found". Errors returned for a code match it with errors.Is:
+//
+//	if errors.Is(err, slack.ErrChannelNotFound) {
+//		...
+//	}
+//
+// Codes missing from the constants, which are generated from
+// internal/errorcodegen/error_codes.csv, can be matched by converting them,
+// e.g. slack.ErrorCode("cant_invite_self").
+type ErrorCode string
+
+func (c ErrorCode) Error() string { return string(c) }
+
+// Class returns the classification of the error code.
+func (c ErrorCode) Class() ErrorClass {
+	if class, ok := errorCodeClasses[c]; ok {
+		return class
+	}
+	switch code := string(c); {
+	case strings.HasSuffix(code, "_not_found"):
+		return ErrorClassNotFound
+	case strings.HasPrefix(code, "invalid_"), strings.HasPrefix(code, "missing_"):
+		return ErrorClassValidation
+	}
+	return ErrorClassUnknown
+}
+
+// ErrorClass groups errors by how callers are expected to handle them.
+type ErrorClass string
+
+const (
+	// ErrorClassUnknown is reported for errors that are not classified.
+	ErrorClassUnknown ErrorClass = ""
+	// ErrorClassAuth errors need a new token.
+	ErrorClassAuth ErrorClass = "auth"
+	// ErrorClassPermission errors need more scopes or access to the resource.
+	ErrorClassPermission ErrorClass = "permission"
+	// ErrorClassNotFound errors refer to a resource that does not exist or is
+	// not visible to the token.
+	ErrorClassNotFound ErrorClass = "not_found"
+	// ErrorClassRetryable errors may succeed when retried later.
+	ErrorClassRetryable ErrorClass = "retryable"
+	// ErrorClassValidation errors are caused by the request arguments.
+	ErrorClassValidation ErrorClass = "validation"
+)
+
+// ClassifyError returns the class of an error returned by the client: Slack
+// error codes, rate limits, HTTP status codes, token type and missing scope
+// errors are classified.
+func ClassifyError(err error) ErrorClass {
+	if err == nil {
+		return ErrorClassUnknown
+	}
+	if slackErr, ok := errors.AsType[SlackErrorResponse](err); ok {
+		return ErrorCode(slackErr.Err).Class()
+	}
+	if _, ok := errors.AsType[*RateLimitedError](err); ok {
+		return ErrorClassRetryable
+	}
+	if codeErr, ok := errors.AsType[StatusCodeError](err); ok {
+		switch {
+		case codeErr.Code >= http.StatusInternalServerError, codeErr.Code == http.StatusTooManyRequests:
+			return ErrorClassRetryable
+		case codeErr.Code == http.StatusUnauthorized:
+			return ErrorClassAuth
+		case codeErr.Code == http.StatusForbidden:
+			return ErrorClassPermission
+		case codeErr.Code == http.StatusNotFound:
+			return ErrorClassNotFound
+		}
+		return ErrorClassUnknown
+	}
+	if _, ok := errors.AsType[TokenTypeError](err); ok {
+		return ErrorClassPermission
+	}
+	if _, ok := errors.AsType[MissingScopesError](err); ok {
+		return ErrorClassPermission
+	}
+	if code, ok := errors.AsType[ErrorCode](err); ok {
+		return code.Class()
+	}
+	return ErrorClassUnknown
+}
+
+// retryLater reports whether a call that failed with err may succeed when
+// made again later: Slack errors are retried when classified as retryable,
+// connection errors always.
+func retryLater(err error) bool {
+	switch ClassifyError(err) {
+	case ErrorClassRetryable:
+		return true
+	case ErrorClassUnknown:
+		_, slackErr := errors.AsType[SlackErrorResponse](err)
+		_, statusErr := errors.AsType[StatusCodeError](err)
+		return !slackErr && !statusErr
+	}
+	return false
+}
+
+// callInfoSetter is satisfied by responses embedding SlackResponse, so that
+// the errors they return can tell which call failed.
+type callInfoSetter interface {
+	setCallInfo(method, requestID string)
+}
+
+// setCallInfo records the method and request ID of resp on dst when Slack
+// answered with an error.
+func setCallInfo(dst any, resp *http.Response) {
+	s, ok := dst.(callInfoSetter)
+	if !ok || resp.Request == nil || resp.Request.URL == nil {
+		return
+	}
+	s.setCallInfo(path.Base(resp.Request.URL.Path), resp.Header.Get("X-Slack-Req-Id"))
+}
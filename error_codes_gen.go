@@ -0,0 +1,171 @@
+// Code generated by internal/errorcodegen from internal/errorcodegen/error_codes.csv. DO NOT EDIT.
+
+package slack
+
+// Authentication errors.
+const (
+	// ErrNotAuthed is returned by any method.
+	ErrNotAuthed ErrorCode = "not_authed"
+	// ErrInvalidAuth is returned by any method.
+	ErrInvalidAuth ErrorCode = "invalid_auth"
+	// ErrAccountInactive is returned by any method.
+	ErrAccountInactive ErrorCode = "account_inactive"
+	// ErrTokenRevoked is returned by any method.
+	ErrTokenRevoked ErrorCode = "token_revoked"
+	// ErrTokenExpired is returned by any method.
+	ErrTokenExpired ErrorCode = "token_expired"
+	// ErrNoSuchTokenFound is returned by any method.
+	ErrNoSuchTokenFound ErrorCode = "no_such_token_found"
+)
+
+// Permission errors.
+const (
+	// ErrMissingScope is returned by any method.
+	ErrMissingScope ErrorCode = "missing_scope"
+	// ErrNotAllowedTokenType is returned by any method.
+	ErrNotAllowedTokenType ErrorCode = "not_allowed_token_type"
+	// ErrNoPermission is returned by any method.
+	ErrNoPermission ErrorCode = "no_permission"
+	// ErrAccessDenied is returned by any method.
+	ErrAccessDenied ErrorCode = "access_denied"
+	// ErrRestrictedAction is returned by chat.*, conversations.*, files.* methods.
+	ErrRestrictedAction ErrorCode = "restricted_action"
+	// ErrNotInChannel is returned by chat.*, conversations.*, pins.*, reactions.* methods.
+	ErrNotInChannel ErrorCode = "not_in_channel"
+	// ErrIsArchived is returned by chat.*, conversations.*, pins.* methods.
+	ErrIsArchived ErrorCode = "is_archived"
+	// ErrCantUpdateMessage is returned by chat.* methods.
+	ErrCantUpdateMessage ErrorCode = "cant_update_message"
+	// ErrCantDeleteMessage is returned by chat.* methods.
+	ErrCantDeleteMessage ErrorCode = "cant_delete_message"
+	// ErrEKMAccessDenied is returned by any method.
+	ErrEKMAccessDenied ErrorCode = "ekm_access_denied"
+	// ErrTeamAccessNotGranted is returned by any method.
+	ErrTeamAccessNotGranted ErrorCode = "team_access_not_granted"
+)
+
+// Not found errors.
+const (
+	// ErrChannelNotFound is returned by bookmarks.*, chat.*, conversations.*, files.*, pins.*, reactions.* methods.
+	ErrChannelNotFound ErrorCode = "channel_not_found"
+	// ErrUserNotFound is returned by conversations.*, usergroups.*, users.* methods.
+	ErrUserNotFound ErrorCode = "user_not_found"
+	// ErrUsersNotFound is returned by conversations.*, users.* methods.
+	ErrUsersNotFound ErrorCode = "users_not_found"
+	// ErrMessageNotFound is returned by chat.*, pins.*, reactions.* methods.
+	ErrMessageNotFound ErrorCode = "message_not_found"
+	// ErrThreadNotFound is returned by chat.*, conversations.* methods.
+	ErrThreadNotFound ErrorCode = "thread_not_found"
+	// ErrFileNotFound is returned by files.*, pins.*, reactions.* methods.
+	ErrFileNotFound ErrorCode = "file_not_found"
+	// ErrBookmarkNotFound is returned by bookmarks.* methods.
+	ErrBookmarkNotFound ErrorCode = "bookmark_not_found"
+	// ErrNotFound is returned by admin.*, files.*, reactions.* methods.
+	ErrNotFound ErrorCode = "not_found"
+	// ErrNoReaction is returned by reactions.* methods.
+	ErrNoReaction ErrorCode = "no_reaction"
+	// ErrTeamNotFound is returned by admin.*, team.* methods.
+	ErrTeamNotFound ErrorCode = "team_not_found"
+	// ErrUsergroupNotFound is returned by usergroups.* methods.
+	ErrUsergroupNotFound ErrorCode = "no_such_subteam"
+)
+
+// Retryable errors.
+const (
+	// ErrRatelimited is returned by any method.
+	ErrRatelimited ErrorCode = "ratelimited"
+	// ErrInternalError is returned by any method.
+	ErrInternalError ErrorCode = "internal_error"
+	// ErrFatalError is returned by any method.
+	ErrFatalError ErrorCode = "fatal_error"
+	// ErrServiceUnavailable is returned by any method.
+	ErrServiceUnavailable ErrorCode = "service_unavailable"
+	// ErrRequestTimeout is returned by any method.
+	ErrRequestTimeout ErrorCode = "request_timeout"
+)
+
+// Validation errors.
+const (
+	// ErrInvalidArguments is returned by any method.
+	ErrInvalidArguments ErrorCode = "invalid_arguments"
+	// ErrInvalidArgName is returned by any method.
+	ErrInvalidArgName ErrorCode = "invalid_arg_name"
+	// ErrInvalidBlocks is returned by chat.* methods.
+	ErrInvalidBlocks ErrorCode = "invalid_blocks"
+	// ErrInvalidBlocksFormat is returned by chat.* methods.
+	ErrInvalidBlocksFormat ErrorCode = "invalid_blocks_format"
+	// ErrInvalidCursor is returned by any method.
+	ErrInvalidCursor ErrorCode = "invalid_cursor"
+	// ErrInvalidTSLatest is returned by conversations.* methods.
+	ErrInvalidTSLatest ErrorCode = "invalid_ts_latest"
+	// ErrInvalidMetadataFormat is returned by chat.* methods.
+	ErrInvalidMetadataFormat ErrorCode = "invalid_metadata_format"
+	// ErrNoText is returned by chat.* methods.
+	ErrNoText ErrorCode = "no_text"
+	// ErrMsgTooLong is returned by chat.* methods.
+	ErrMsgTooLong ErrorCode = "msg_too_long"
+	// ErrTooManyAttachments is returned by chat.* methods.
+	ErrTooManyAttachments ErrorCode = "too_many_attachments"
+	// ErrAlreadyReacted is returned by reactions.* methods.
+	ErrAlreadyReacted ErrorCode = "already_reacted"
+	// ErrAlreadyInChannel is returned by conversations.* methods.
+	ErrAlreadyInChannel ErrorCode = "already_in_channel"
+	// ErrUserNotInChannel is returned by conversations.* methods.
+	ErrUserNotInChannel ErrorCode = "user_not_in_channel"
+	// ErrNameTaken is returned by conversations.*, usergroups.* methods.
+	ErrNameTaken ErrorCode = "name_taken"
+)
+
+var errorCodeClasses = map[ErrorCode]ErrorClass{
+	ErrNotAuthed:        ErrorClassAuth,
+	ErrInvalidAuth:      ErrorClassAuth,
+	ErrAccountInactive:  ErrorClassAuth,
+	ErrTokenRevoked:     ErrorClassAuth,
+	ErrTokenExpired:     ErrorClassAuth,
+	ErrNoSuchTokenFound: ErrorClassAuth,
+
+	ErrMissingScope:         ErrorClassPermission,
+	ErrNotAllowedTokenType:  ErrorClassPermission,
+	ErrNoPermission:         ErrorClassPermission,
+	ErrAccessDenied:         ErrorClassPermission,
+	ErrRestrictedAction:     ErrorClassPermission,
+	ErrNotInChannel:         ErrorClassPermission,
+	ErrIsArchived:           ErrorClassPermission,
+	ErrCantUpdateMessage:    ErrorClassPermission,
+	ErrCantDeleteMessage:    ErrorClassPermission,
+	ErrEKMAccessDenied:      ErrorClassPermission,
+	ErrTeamAccessNotGranted: ErrorClassPermission,
+
+	ErrChannelNotFound:   ErrorClassNotFound,
+	ErrUserNotFound:      ErrorClassNotFound,
+	ErrUsersNotFound:     ErrorClassNotFound,
+	ErrMessageNotFound:   ErrorClassNotFound,
+	ErrThreadNotFound:    ErrorClassNotFound,
+	ErrFileNotFound:      ErrorClassNotFound,
+	ErrBookmarkNotFound:  ErrorClassNotFound,
+	ErrNotFound:          ErrorClassNotFound,
+	ErrNoReaction:        ErrorClassNotFound,
+	ErrTeamNotFound:      ErrorClassNotFound,
+	ErrUsergroupNotFound: ErrorClassNotFound,
+
+	ErrRatelimited:        ErrorClassRetryable,
+	ErrInternalError:      ErrorClassRetryable,
+	ErrFatalError:         ErrorClassRetryable,
+	ErrServiceUnavailable: ErrorClassRetryable,
+	ErrRequestTimeout:     ErrorClassRetryable,
+
+	ErrInvalidArguments:      ErrorClassValidation,
+	ErrInvalidArgName:        ErrorClassValidation,
+	ErrInvalidBlocks:         ErrorClassValidation,
+	ErrInvalidBlocksFormat:   ErrorClassValidation,
+	ErrInvalidCursor:         ErrorClassValidation,
+	ErrInvalidTSLatest:       ErrorClassValidation,
+	ErrInvalidMetadataFormat: ErrorClassValidation,
+	ErrNoText:                ErrorClassValidation,
+	ErrMsgTooLong:            ErrorClassValidation,
+	ErrTooManyAttachments:    ErrorClassValidation,
+	ErrAlreadyReacted:        ErrorClassValidation,
+	ErrAlreadyInChannel:      ErrorClassValidation,
+	ErrUserNotInChannel:      ErrorClassValidation,
+	ErrNameTaken:             ErrorClassValidation,
+}
@@ -0,0 +1,73 @@
+package slack
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorCodes(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("X-Slack-Req-Id", "req-1")
+		rw.Write([]byte(`{"ok": false, "error": "channel_not_found", "response_metadata": {"messages": ["[ERROR] no such channel"]}}`))
+	})
+	mux.HandleFunc("/conversations.info", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": false, "error": "missing_scope", "needed": "channels:read"}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	api := New("xoxb-test", OptionAPIURL(server.URL+"/"))
+
+	_, _, err := api.PostMessage("C1", MsgOptionText("hi", false))
+	assert.ErrorIs(t, err, ErrChannelNotFound)
+	assert.NotErrorIs(t, err, ErrNotInChannel)
+	slackErr, ok := errors.AsType[SlackErrorResponse](err)
+	require.True(t, ok)
+	assert.Equal(t, "chat.postMessage", slackErr.Method())
+	assert.Equal(t, "req-1", slackErr.RequestID())
+	assert.Equal(t, []string{"[ERROR] no such channel"}, slackErr.ResponseMetadata.Messages)
+	assert.Equal(t, ErrorClassNotFound, ClassifyError(err))
+	assert.Equal(t, "channel_not_found", err.Error(), "the message is unchanged")
+
+	_, err = api.GetConversationInfo(&GetConversationInfoInput{ChannelID: "C1"})
+	assert.ErrorIs(t, err, ErrMissingScope)
+	assert.Equal(t, ErrorClassPermission, ClassifyError(err))
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want ErrorClass
+	}{
+		{nil, ErrorClassUnknown},
+		{errors.New("boom"), ErrorClassUnknown},
+		{SlackErrorResponse{Err: "invalid_auth"}, ErrorClassAuth},
+		{SlackErrorResponse{Err: "ratelimited"}, ErrorClassRetryable},
+		{SlackErrorResponse{Err: "invalid_blocks"}, ErrorClassValidation},
+		{SlackErrorResponse{Err: "invalid_something_new"}, ErrorClassValidation},
+		{SlackErrorResponse{Err: "canvas_not_found"}, ErrorClassNotFound},
+		{SlackErrorResponse{Err: "unheard_of"}, ErrorClassUnknown},
+		{&RateLimitedError{}, ErrorClassRetryable},
+		{StatusCodeError{Code: http.StatusBadGateway}, ErrorClassRetryable},
+		{StatusCodeError{Code: http.StatusForbidden}, ErrorClassPermission},
+		{TokenTypeError{Method: "apps.manifest.create", Want: TokenTypeConfig, Got: TokenTypeBot}, ErrorClassPermission},
+		{MissingScopesError{}, ErrorClassPermission},
+		{ErrTokenRevoked, ErrorClassAuth},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, ClassifyError(test.err), "%v", test.err)
+	}
+}
+
+func TestErrorCodeSentinels(t *testing.T) {
+	assert.ErrorIs(t, &RateLimitedError{}, ErrRatelimited)
+	assert.ErrorIs(t, TokenTypeError{Method: "admin.users.list", Want: TokenTypeUser, Got: TokenTypeBot}, ErrNotAllowedTokenType)
+	assert.ErrorIs(t, SlackErrorResponse{Err: "cant_invite_self"}, ErrorCode("cant_invite_self"))
+}
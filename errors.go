@@ -13,6 +13,15 @@ const (
 	ErrInvalidConfiguration = errorsx.String("invalid configuration")
 	ErrMissingHeaders       = errorsx.String("missing headers")
 	ErrExpiredTimestamp     = errorsx.String("timestamp is too old")
+	// ErrInstallationNotFound is returned by an InstallationStore when no
+	// installation matches the query.
+	ErrInstallationNotFound = errorsx.String("installation not found")
+	// ErrOAuthStateInvalid is returned when the state of an OAuth redirect is
+	// missing, unknown, expired or was issued to another browser.
+	ErrOAuthStateInvalid = errorsx.String("invalid oauth state")
+	// ErrScopesUnknown is returned by CheckScopes when Slack did not report
+	// the scopes granted to the token.
+	ErrScopesUnknown = errorsx.String("granted scopes unknown")
 )
 
 // internal errors
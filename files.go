@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -273,7 +274,9 @@ func (api *Client) GetFile(downloadURL string, writer io.Writer) error {
 // GetFileContext retrieves a given file from its private download URL with a custom context.
 // For more details, see GetFile documentation.
 func (api *Client) GetFileContext(ctx context.Context, downloadURL string, writer io.Writer) error {
-	return downloadFile(ctx, api.httpclient, api.token, downloadURL, writer, api)
+	return api.doWithToken(ctx, "files.download", nil, nil, func(ctx context.Context, token string) (http.Header, error) {
+		return nil, downloadFile(ctx, api.httpclient, token, downloadURL, writer, api)
+	})
 }
 
 // GetFiles retrieves all files according to the parameters given.
@@ -479,16 +482,18 @@ func (api *Client) GetUploadURLExternalContext(ctx context.Context, params GetUp
 // This is not a Slack API method, but a helper function to upload files to the URL
 func (api *Client) UploadToURL(ctx context.Context, params UploadToURLParameters) (err error) {
 	values := url.Values{}
-	switch {
-	case params.Content != "":
-		contentReader := strings.NewReader(params.Content)
-		err = postWithMultipartResponse(ctx, api.httpclient, params.UploadURL, params.Filename, "file", api.token, values, contentReader, nil, api)
-	case params.File != "":
-		err = postLocalWithMultipartResponse(ctx, api.httpclient, params.UploadURL, params.File, "file", api.token, values, nil, api)
-	case params.Reader != nil:
-		err = postWithMultipartResponse(ctx, api.httpclient, params.UploadURL, params.Filename, "file", api.token, values, params.Reader, nil, api)
-	}
-	return err
+	return api.doWithToken(ctx, "files.uploadToURL", values, nil, func(ctx context.Context, token string) (http.Header, error) {
+		switch {
+		case params.Content != "":
+			contentReader := strings.NewReader(params.Content)
+			return nil, postWithMultipartResponse(ctx, api.httpclient, params.UploadURL, params.Filename, "file", token, values, contentReader, nil, api)
+		case params.File != "":
+			return nil, postLocalWithMultipartResponse(ctx, api.httpclient, params.UploadURL, params.File, "file", token, values, nil, api)
+		case params.Reader != nil:
+			return nil, postWithMultipartResponse(ctx, api.httpclient, params.UploadURL, params.Filename, "file", token, values, params.Reader, nil, api)
+		}
+		return nil, nil
+	})
 }
 
 // CompleteUploadExternalContext once files are uploaded, this completes the upload and shares it to the specified channels
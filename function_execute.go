@@ -49,7 +49,7 @@ func (api *Client) FunctionCompleteSuccessContext(ctx context.Context, functionE
 	}
 
 	response := &SlackResponse{}
-	if err := api.postJSONMethod(ctx, "functions.completeSuccess", api.token, jsonData, response); err != nil {
+	if err := api.postJSONMethod(ctx, "functions.completeSuccess", jsonData, response); err != nil {
 		return err
 	}
 
@@ -79,7 +79,7 @@ func (api *Client) FunctionCompleteErrorContext(ctx context.Context, functionExe
 	}
 
 	response := &SlackResponse{}
-	if err := api.postJSONMethod(ctx, "functions.completeError", api.token, jsonData, response); err != nil {
+	if err := api.postJSONMethod(ctx, "functions.completeError", jsonData, response); err != nil {
 		return err
 	}
 
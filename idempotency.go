@@ -0,0 +1,195 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"time"
+)
+
+const (
+	// IdempotencyKeyField is the metadata event payload field holding the
+	// key set with MsgOptionIdempotencyKey.
+	IdempotencyKeyField = "idempotency_key"
+	// IdempotencyEventType is the metadata event type of messages posted
+	// with MsgOptionIdempotencyKey and no MsgOptionMetadata.
+	IdempotencyEventType = "idempotent_message"
+)
+
+const (
+	// idempotentAttempts is how many times a message with an idempotency key
+	// is sent when sending fails ambiguously.
+	idempotentAttempts = 3
+	// idempotentLookback is how long before the first attempt a message
+	// found in the conversation may have been posted, allowing for clock
+	// skew with Slack.
+	idempotentLookback = time.Minute
+	// idempotentLookupLimit is the number of recent messages searched for
+	// the key.
+	idempotentLookupLimit = 200
+	// idempotentLookupTimeout bounds the lookup done after the context of
+	// the call expired.
+	idempotentLookupTimeout = 10 * time.Second
+)
+
+// MsgOptionIdempotencyKey makes chat.postMessage post the message at most
+// once. The key is stored in the message metadata under IdempotencyKeyField,
+// next to any MsgOptionMetadata payload.
+//
+// When sending fails without telling whether the message was posted, e.g. on
+// a timeout, a connection error or a 5xx response, the recent messages of the
+// conversation (or of the thread, for replies) are searched for the key, and
+// the timestamp of the message found is returned. Otherwise the message is
+// sent again, up to three times in total. Retries of such failures by
+// OptionRetry are disabled for these messages.
+//
+// The lookup needs the history scope of the conversation, and the channel ID
+// of a conversation rather than a user ID.
+func MsgOptionIdempotencyKey(key string) MsgOption {
+	return func(config *sendConfig) error {
+		config.idempotencyKey = key
+		return nil
+	}
+}
+
+// tagIdempotencyKey adds the idempotency key to the message metadata.
+func (t *sendConfig) tagIdempotencyKey() error {
+	metadata := SlackMetadata{
+		EventType:    t.metadata.EventType,
+		EventPayload: maps.Clone(t.metadata.EventPayload),
+	}
+	if metadata.EventType == "" {
+		metadata.EventType = IdempotencyEventType
+	}
+	if metadata.EventPayload == nil {
+		metadata.EventPayload = map[string]any{}
+	}
+	metadata.EventPayload[IdempotencyKeyField] = t.idempotencyKey
+
+	meta, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	t.metadata = metadata
+	t.values.Set("metadata", string(meta))
+	return nil
+}
+
+// idempotentSendKey marks the context of idempotent sends, whose ambiguous
+// failures retryClient leaves to sendIdempotent.
+type idempotentSendKey struct{}
+
+func isIdempotentSend(ctx context.Context) bool {
+	_, ok := ctx.Value(idempotentSendKey{}).(bool)
+	return ok
+}
+
+// sendIdempotent posts a message tagged with an idempotency key, looking for
+// it in the conversation before sending it again after an ambiguous failure.
+func (api *Client) sendIdempotent(ctx context.Context, channelID string, config sendConfig, options ...MsgOption) (*chatResponseFull, error) {
+	since := time.Now().Add(-idempotentLookback)
+	threadTS := config.values.Get("thread_ts")
+
+	var err error
+	for attempt := range idempotentAttempts {
+		if attempt > 0 {
+			if response := api.findIdempotentMessage(ctx, channelID, threadTS, config.idempotencyKey, since); response != nil {
+				return response, nil
+			}
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			api.Debugf("Message with idempotency key %q not found, sending it again", config.idempotencyKey)
+		}
+
+		var response *chatResponseFull
+		// Only the post is marked: the lookups are retried as usual.
+		response, err = api.sendResponseOnce(context.WithValue(ctx, idempotentSendKey{}, true), channelID, options...)
+		if !ambiguousSendFailure(response, err) {
+			return response, err
+		}
+	}
+	return nil, err
+}
+
+// findIdempotentMessage returns the message posted with key since the given
+// time, or nil if it is not found or the lookup fails.
+func (api *Client) findIdempotentMessage(ctx context.Context, channelID, threadTS, key string, since time.Time) *chatResponseFull {
+	if ctx.Err() != nil {
+		// The message may have been posted just as the call timed out.
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.WithoutCancel(ctx), idempotentLookupTimeout)
+		defer cancel()
+	}
+
+	oldest := fmt.Sprintf("%d.%06d", since.Unix(), since.Nanosecond()/1000)
+	var (
+		messages []Message
+		err      error
+	)
+	if threadTS != "" {
+		messages, _, _, err = api.GetConversationRepliesContext(ctx, &GetConversationRepliesParameters{
+			ChannelID:          channelID,
+			Timestamp:          threadTS,
+			Oldest:             oldest,
+			Limit:              idempotentLookupLimit,
+			IncludeAllMetadata: true,
+		})
+	} else {
+		var history *GetConversationHistoryResponse
+		history, err = api.GetConversationHistoryContext(ctx, &GetConversationHistoryParameters{
+			ChannelID:          channelID,
+			Oldest:             oldest,
+			Limit:              idempotentLookupLimit,
+			IncludeAllMetadata: true,
+		})
+		if history != nil {
+			messages = history.Messages
+		}
+	}
+	if err != nil {
+		api.Debugf("Failed to look up message with idempotency key %q: %v", key, err)
+		return nil
+	}
+
+	for _, msg := range messages {
+		if msg.Metadata.EventPayload[IdempotencyKeyField] == key {
+			return &chatResponseFull{
+				Channel:       channelID,
+				Timestamp:     msg.Timestamp,
+				Text:          msg.Text,
+				Message:       msg,
+				SlackResponse: SlackResponse{Ok: true},
+			}
+		}
+	}
+	return nil
+}
+
+// ambiguousSendFailure reports whether a message may have been posted even
+// though sending it failed.
+func ambiguousSendFailure(response *chatResponseFull, err error) bool {
+	if err == nil {
+		return false
+	}
+	if response != nil {
+		// Slack answered; only its own failures leave the outcome unknown.
+		code := response.Error
+		return code == string(ErrInternalError) || code == string(ErrFatalError) ||
+			code == string(ErrServiceUnavailable) || code == string(ErrRequestTimeout)
+	}
+	if _, ok := errors.AsType[*RateLimitedError](err); ok {
+		return false
+	}
+	if _, ok := errors.AsType[TokenTypeError](err); ok {
+		return false
+	}
+	if codeErr, ok := errors.AsType[StatusCodeError](err); ok {
+		return codeErr.Code >= http.StatusInternalServerError
+	}
+	// Timeouts, connection errors and unreadable responses.
+	return true
+}
@@ -0,0 +1,164 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// idempotencyServer fails chat.postMessage with a 502 for the first
+// failures posts, posting the message anyway when posted is set, and
+// conversations.history for the first historyFailures lookups.
+type idempotencyServer struct {
+	*httptest.Server
+
+	mu              sync.Mutex
+	failures        int
+	posted          bool
+	historyFailures int
+	messages        []Message
+	calls           []string
+}
+
+func newIdempotencyServer(t *testing.T, failures int, posted bool) *idempotencyServer {
+	s := &idempotencyServer{failures: failures, posted: posted}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.calls = append(s.calls, "chat.postMessage")
+
+		var metadata SlackMetadata
+		require.NoError(t, json.Unmarshal([]byte(r.FormValue("metadata")), &metadata))
+		msg := Message{Msg: Msg{Timestamp: fmt.Sprintf("1700000000.%06d", len(s.messages)+1), Text: r.FormValue("text"), Metadata: metadata}}
+
+		if s.failures > 0 {
+			s.failures--
+			if s.posted {
+				s.messages = append(s.messages, msg)
+			}
+			rw.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		s.messages = append(s.messages, msg)
+		rw.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(rw).Encode(map[string]any{"ok": true, "channel": "C1", "ts": msg.Timestamp, "message": msg})
+	})
+	mux.HandleFunc("/conversations.history", func(rw http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.calls = append(s.calls, "conversations.history")
+		assert.Equal(t, "1", r.FormValue("include_all_metadata"))
+		if s.historyFailures > 0 {
+			s.historyFailures--
+			rw.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(rw).Encode(map[string]any{"ok": true, "messages": s.messages})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestMsgOptionIdempotencyKey(t *testing.T) {
+	_, values, err := UnsafeApplyMsgOptions("xoxb-test", "C1", APIURL,
+		MsgOptionIdempotencyKey("key-1"),
+		MsgOptionMetadata(SlackMetadata{EventType: "order_created", EventPayload: map[string]any{"id": "42"}}),
+	)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"event_type": "order_created", "event_payload": {"id": "42", "idempotency_key": "key-1"}}`, values.Get("metadata"))
+
+	_, values, err = UnsafeApplyMsgOptions("xoxb-test", "C1", APIURL, MsgOptionIdempotencyKey("key-1"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"event_type": "idempotent_message", "event_payload": {"idempotency_key": "key-1"}}`, values.Get("metadata"))
+}
+
+func TestIdempotentPostFindsPostedMessage(t *testing.T) {
+	s := newIdempotencyServer(t, 1, true)
+	api := New("xoxb-test", OptionAPIURL(s.URL+"/"), OptionRetry(3))
+
+	channel, ts, err := api.PostMessage("C1", MsgOptionText("hi", false), MsgOptionIdempotencyKey("key-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "C1", channel)
+	assert.Equal(t, "1700000000.000001", ts)
+	assert.Equal(t, []string{"chat.postMessage", "conversations.history"}, s.calls, "the 502 is not retried by OptionRetry")
+	assert.Len(t, s.messages, 1)
+}
+
+func TestIdempotentPostRetriesLookup(t *testing.T) {
+	s := newIdempotencyServer(t, 1, true)
+	s.historyFailures = 1
+	retry := DefaultRetryConfig()
+	retry.Handlers = append(DefaultRetryHandlers(retry), NewServerErrorRetryHandler(retry))
+	api := New("xoxb-test", OptionAPIURL(s.URL+"/"), OptionRetryConfig(retry))
+
+	_, ts, err := api.PostMessage("C1", MsgOptionText("hi", false), MsgOptionIdempotencyKey("key-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "1700000000.000001", ts)
+	assert.Equal(t, []string{"chat.postMessage", "conversations.history", "conversations.history"}, s.calls, "the 502 of the lookup is retried, not the one of the post")
+	assert.Len(t, s.messages, 1)
+}
+
+func TestIdempotentPostSendsAgain(t *testing.T) {
+	s := newIdempotencyServer(t, 1, false)
+	api := New("xoxb-test", OptionAPIURL(s.URL+"/"))
+
+	_, ts, err := api.PostMessage("C1", MsgOptionText("hi", false), MsgOptionIdempotencyKey("key-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "1700000000.000001", ts)
+	assert.Equal(t, []string{"chat.postMessage", "conversations.history", "chat.postMessage"}, s.calls)
+}
+
+func TestIdempotentPostGivesUp(t *testing.T) {
+	s := newIdempotencyServer(t, idempotentAttempts, false)
+	api := New("xoxb-test", OptionAPIURL(s.URL+"/"))
+
+	_, _, err := api.PostMessage("C1", MsgOptionText("hi", false), MsgOptionIdempotencyKey("key-1"))
+	assert.Equal(t, StatusCodeError{Code: http.StatusBadGateway, Status: "502 Bad Gateway"}, err)
+	assert.Len(t, s.calls, 2*idempotentAttempts-1)
+}
+
+func TestIdempotentPostAfterTimeout(t *testing.T) {
+	s := newIdempotencyServer(t, 0, false)
+	s.Config.Handler = func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(rw, r)
+			if r.URL.Path == "/chat.postMessage" {
+				// The message is posted but the response comes too late.
+				time.Sleep(100 * time.Millisecond)
+			}
+		})
+	}(s.Config.Handler)
+	api := New("xoxb-test", OptionAPIURL(s.URL+"/"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, ts, err := api.PostMessageContext(ctx, "C1", MsgOptionText("hi", false), MsgOptionIdempotencyKey("key-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "1700000000.000001", ts)
+}
+
+func TestIdempotentPostSlackError(t *testing.T) {
+	s := newIdempotencyServer(t, 0, false)
+	s.Config.Handler = http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		s.calls = append(s.calls, r.URL.Path)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": false, "error": "channel_not_found"}`))
+	})
+	api := New("xoxb-test", OptionAPIURL(s.URL+"/"))
+
+	_, _, err := api.PostMessage("C1", MsgOptionText("hi", false), MsgOptionIdempotencyKey("key-1"))
+	assert.ErrorIs(t, err, ErrChannelNotFound)
+	assert.Equal(t, []string{"/chat.postMessage"}, s.calls)
+}
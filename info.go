@@ -327,7 +327,7 @@ func (api *Client) GetUserPrefs() (*UserPrefsCarrier, error) {
 func (api *Client) GetUserPrefsContext(ctx context.Context) (*UserPrefsCarrier, error) {
 	response := UserPrefsCarrier{}
 
-	err := api.getMethod(ctx, "users.prefs.get", api.token, url.Values{}, &response)
+	err := api.getMethod(ctx, "users.prefs.get", url.Values{}, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,224 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Installation holds the tokens and metadata of an app installed in a
+// workspace or, for org-wide apps, an Enterprise Grid organization.
+type Installation struct {
+	AppID               string
+	EnterpriseID        string
+	EnterpriseName      string
+	TeamID              string
+	TeamName            string
+	IsEnterpriseInstall bool
+
+	BotToken          string
+	BotID             string
+	BotUserID         string
+	BotScopes         string
+	BotRefreshToken   string
+	BotTokenExpiresAt time.Time
+
+	UserID             string
+	UserToken          string
+	UserScopes         string
+	UserRefreshToken   string
+	UserTokenExpiresAt time.Time
+
+	IncomingWebhook OAuthResponseIncomingWebhook
+	InstalledAt     time.Time
+}
+
+// InstallationQuery identifies an installation. For org-wide installations
+// TeamID may be empty and IsEnterpriseInstall should be set. UserID selects
+// the installation made by that user, which carries their user token.
+type InstallationQuery struct {
+	EnterpriseID        string
+	TeamID              string
+	UserID              string
+	IsEnterpriseInstall bool
+}
+
+// InstallationStore persists installations so that a single Client can act
+// on behalf of any workspace the app is installed in.
+type InstallationStore interface {
+	SaveInstallation(ctx context.Context, installation *Installation) error
+	// FindInstallation returns ErrInstallationNotFound when no installation
+	// matches the query.
+	FindInstallation(ctx context.Context, query InstallationQuery) (*Installation, error)
+	DeleteInstallation(ctx context.Context, query InstallationQuery) error
+}
+
+type installationKey struct {
+	enterpriseID string
+	teamID       string
+	userID       string
+}
+
+func (q InstallationQuery) key() installationKey {
+	k := installationKey{enterpriseID: q.EnterpriseID, teamID: q.TeamID, userID: q.UserID}
+	if q.IsEnterpriseInstall {
+		k.teamID = ""
+	}
+	return k
+}
+
+// MemoryInstallationStore is an InstallationStore keeping installations in
+// memory. It is useful for tests and single-process apps.
+type MemoryInstallationStore struct {
+	mu            sync.RWMutex
+	installations map[installationKey]Installation
+}
+
+// NewMemoryInstallationStore returns an empty MemoryInstallationStore.
+func NewMemoryInstallationStore() *MemoryInstallationStore {
+	return &MemoryInstallationStore{installations: make(map[installationKey]Installation)}
+}
+
+// SaveInstallation stores the installation as the latest one for its
+// workspace (or organization) and, when it carries a user token, as the
+// installation of that user.
+func (s *MemoryInstallationStore) SaveInstallation(ctx context.Context, installation *Installation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := InstallationQuery{
+		EnterpriseID:        installation.EnterpriseID,
+		TeamID:              installation.TeamID,
+		IsEnterpriseInstall: installation.IsEnterpriseInstall,
+	}
+	s.installations[query.key()] = *installation
+	if installation.UserID != "" && installation.UserToken != "" {
+		query.UserID = installation.UserID
+		s.installations[query.key()] = *installation
+	}
+	return nil
+}
+
+// FindInstallation returns a copy of the matching installation. A query
+// for a workspace of an organization with an org-wide installation falls
+// back to that installation.
+func (s *MemoryInstallationStore) FindInstallation(ctx context.Context, query InstallationQuery) (*Installation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if installation, ok := s.installations[query.key()]; ok {
+		return &installation, nil
+	}
+	if query.EnterpriseID != "" && !query.IsEnterpriseInstall {
+		query.IsEnterpriseInstall = true
+		if installation, ok := s.installations[query.key()]; ok {
+			return &installation, nil
+		}
+	}
+	return nil, ErrInstallationNotFound
+}
+
+// DeleteInstallation removes the matching installation. Without a UserID it
+// removes the workspace installation and all user installations in it.
+func (s *MemoryInstallationStore) DeleteInstallation(ctx context.Context, query InstallationQuery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := query.key()
+	if key.userID != "" {
+		delete(s.installations, key)
+		return nil
+	}
+	maps.DeleteFunc(s.installations, func(k installationKey, _ Installation) bool {
+		return k.enterpriseID == key.enterpriseID && k.teamID == key.teamID
+	})
+	return nil
+}
+
+// OptionInstallationStore sets the store used to resolve tokens for calls
+// made with a context from WithBotTokenFor or WithUserTokenFor.
+func OptionInstallationStore(store InstallationStore) func(*Client) {
+	return func(c *Client) { c.installationStore = store }
+}
+
+type tokenOverrideKey struct{}
+
+type tokenOverride struct {
+	token string
+	query *InstallationQuery
+	user  bool
+}
+
+// WithToken returns a context that makes Client calls use token instead of
+// the token the Client was created with. App-level and configuration tokens
+// are not affected.
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenOverrideKey{}, tokenOverride{token: token})
+}
+
+// WithBotTokenFor returns a context that makes Client calls use the bot token
+// of the installation for the given enterprise and team, looked up in the
+// InstallationStore set with OptionInstallationStore when the call is made.
+// enterpriseID may be empty for workspaces outside Enterprise Grid, and
+// teamID may be empty for org-wide installations.
+//
+//	ctx = slack.WithBotTokenFor(ctx, ev.EnterpriseID, ev.TeamID)
+//	api.PostMessageContext(ctx, channelID, slack.MsgOptionText("hi", false))
+func WithBotTokenFor(ctx context.Context, enterpriseID, teamID string) context.Context {
+	query := InstallationQuery{EnterpriseID: enterpriseID, TeamID: teamID, IsEnterpriseInstall: teamID == ""}
+	return context.WithValue(ctx, tokenOverrideKey{}, tokenOverride{query: &query})
+}
+
+// WithUserTokenFor is like WithBotTokenFor but uses the user token of the
+// given user's installation.
+func WithUserTokenFor(ctx context.Context, enterpriseID, teamID, userID string) context.Context {
+	query := InstallationQuery{EnterpriseID: enterpriseID, TeamID: teamID, UserID: userID, IsEnterpriseInstall: teamID == ""}
+	return context.WithValue(ctx, tokenOverrideKey{}, tokenOverride{query: &query, user: true})
+}
+
+// resolveToken returns the token to send in place of the client token: the
+// one given by the context, if any, or the rotated token.
+func (api *Client) resolveToken(ctx context.Context) (string, error) {
+	override, ok := ctx.Value(tokenOverrideKey{}).(tokenOverride)
+	if !ok {
+		if api.tokenRotator != nil {
+			return api.tokenRotator.accessToken(ctx)
+		}
+		return api.token, nil
+	}
+	if override.query == nil {
+		return override.token, nil
+	}
+	if api.installationStore == nil {
+		return "", fmt.Errorf("%w: no installation store, see OptionInstallationStore", ErrInvalidConfiguration)
+	}
+
+	installation, err := api.installationStore.FindInstallation(ctx, *override.query)
+	if err != nil {
+		return "", err
+	}
+	if override.user {
+		if installation.UserToken == "" {
+			return "", ErrInstallationNotFound
+		}
+		return installation.UserToken, nil
+	}
+	if installation.BotToken == "" {
+		return "", ErrInstallationNotFound
+	}
+	return installation.BotToken, nil
+}
+
+// valuesWithToken returns values with its token replaced by token. values
+// is copied rather than modified.
+func valuesWithToken(values url.Values, token string) url.Values {
+	if values.Get("token") == token {
+		return values
+	}
+	values = maps.Clone(values)
+	values["token"] = []string{token}
+	return values
+}
@@ -0,0 +1,140 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryInstallationStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryInstallationStore()
+
+	require.NoError(t, store.SaveInstallation(ctx, &Installation{
+		TeamID:    "T1",
+		BotToken:  "xoxb-t1",
+		UserID:    "U1",
+		UserToken: "xoxp-u1",
+	}))
+	require.NoError(t, store.SaveInstallation(ctx, &Installation{
+		EnterpriseID:        "E1",
+		IsEnterpriseInstall: true,
+		BotToken:            "xoxb-e1",
+	}))
+
+	installation, err := store.FindInstallation(ctx, InstallationQuery{TeamID: "T1"})
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-t1", installation.BotToken)
+
+	installation, err = store.FindInstallation(ctx, InstallationQuery{TeamID: "T1", UserID: "U1"})
+	require.NoError(t, err)
+	assert.Equal(t, "xoxp-u1", installation.UserToken)
+
+	// Workspaces of an organization fall back to the org-wide installation.
+	installation, err = store.FindInstallation(ctx, InstallationQuery{EnterpriseID: "E1", TeamID: "T9"})
+	require.NoError(t, err)
+	assert.Equal(t, "xoxb-e1", installation.BotToken)
+
+	_, err = store.FindInstallation(ctx, InstallationQuery{TeamID: "T2"})
+	assert.ErrorIs(t, err, ErrInstallationNotFound)
+
+	require.NoError(t, store.DeleteInstallation(ctx, InstallationQuery{TeamID: "T1"}))
+	_, err = store.FindInstallation(ctx, InstallationQuery{TeamID: "T1", UserID: "U1"})
+	assert.ErrorIs(t, err, ErrInstallationNotFound)
+}
+
+func TestClientTokenOverride(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	var gotTokens []string
+	http.HandleFunc("/auth.test", func(rw http.ResponseWriter, r *http.Request) {
+		gotTokens = append(gotTokens, r.FormValue("token"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	http.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		gotTokens = append(gotTokens, r.FormValue("token"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "channel": "C1", "ts": "1.2"}`))
+	})
+	http.HandleFunc("/views.publish", func(rw http.ResponseWriter, r *http.Request) {
+		gotTokens = append(gotTokens, r.Header.Get("Authorization"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	once.Do(startServer)
+
+	store := NewMemoryInstallationStore()
+	require.NoError(t, store.SaveInstallation(context.Background(), &Installation{
+		EnterpriseID: "E1",
+		TeamID:       "T1",
+		BotToken:     "xoxb-t1",
+		UserID:       "U1",
+		UserToken:    "xoxp-u1",
+	}))
+	api := New("", OptionAPIURL("http://"+serverAddr+"/"), OptionInstallationStore(store))
+
+	ctx := context.Background()
+	_, err := api.AuthTestContext(WithBotTokenFor(ctx, "E1", "T1"))
+	require.NoError(t, err)
+	_, err = api.AuthTestContext(WithUserTokenFor(ctx, "E1", "T1", "U1"))
+	require.NoError(t, err)
+	_, err = api.AuthTestContext(WithToken(ctx, "xoxb-explicit"))
+	require.NoError(t, err)
+	_, _, err = api.PostMessageContext(WithBotTokenFor(ctx, "E1", "T1"), "C1", MsgOptionText("hi", false))
+	require.NoError(t, err)
+	_, err = api.PublishViewContext(WithBotTokenFor(ctx, "E1", "T1"), PublishViewContextRequest{UserID: "U1", View: HomeTabViewRequest{Type: VTHomeTab}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"xoxb-t1", "xoxp-u1", "xoxb-explicit", "xoxb-t1", "Bearer xoxb-t1"}, gotTokens)
+
+	_, err = api.AuthTestContext(WithBotTokenFor(ctx, "E2", "T2"))
+	assert.ErrorIs(t, err, ErrInstallationNotFound)
+}
+
+func TestClientTokenOverrideKeepsAppLevelToken(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		tokens = map[string]string{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" {
+			token = r.FormValue("token")
+		}
+		mu.Lock()
+		tokens[r.URL.Path] = token
+		mu.Unlock()
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+	api := New("xoxb-default", OptionAppLevelToken("xapp-1"), OptionAPIURL(server.URL+"/"))
+	ctx := WithToken(context.Background(), "xoxb-other")
+
+	_, _, err := api.StartSocketModeContext(ctx)
+	require.NoError(t, err)
+	_, err = api.AuthTestContext(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"/apps.connections.open": "xapp-1", "/auth.test": "xoxb-other"}, tokens)
+}
+
+func TestClientTokenOverrideWithEmptyTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected call to %s", r.URL.Path)
+	}))
+	defer server.Close()
+	// The client, app-level and configuration tokens are all empty.
+	api := New("", OptionAPIURL(server.URL+"/"))
+	ctx := WithToken(context.Background(), "xoxb-bot")
+
+	_, _, err := api.StartSocketModeContext(ctx)
+	assert.Equal(t, TokenTypeError{Method: "apps.connections.open", Want: TokenTypeApp, Missing: true}, err)
+	_, err = api.ExportManifestContext(ctx, "", "A1")
+	assert.Equal(t, TokenTypeError{Method: "apps.manifest.export", Want: TokenTypeConfig, Missing: true}, err)
+}
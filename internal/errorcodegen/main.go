@@ -0,0 +1,176 @@
+// Command errorcodegen generates the ErrorCode constants of the slack package
+// and their classes from error_codes.csv.
+//
+// It is run by go generate in the repository root:
+//
+//	go run ./internal/errorcodegen -in internal/errorcodegen/error_codes.csv -out error_codes_gen.go
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// class is an error class, with the identifier of its ErrorClass constant
+// and the heading of its group of error codes.
+type class struct {
+	Name    string
+	Ident   string
+	Heading string
+}
+
+// classes lists the error classes in the order their constants are
+// generated.
+var classes = []class{
+	{"auth", "ErrorClassAuth", "Authentication errors."},
+	{"permission", "ErrorClassPermission", "Permission errors."},
+	{"not_found", "ErrorClassNotFound", "Not found errors."},
+	{"retryable", "ErrorClassRetryable", "Retryable errors."},
+	{"validation", "ErrorClassValidation", "Validation errors."},
+}
+
+// errorCode is a row of error_codes.csv.
+type errorCode struct {
+	Name     string
+	Code     string
+	Class    string
+	Families []string
+}
+
+// Doc returns the doc comment of the constant, naming the API families
+// returning the code.
+func (c errorCode) Doc() string {
+	if slices.Equal(c.Families, []string{"*"}) {
+		return fmt.Sprintf("%s is returned by any method.", c.Name)
+	}
+	families := make([]string, len(c.Families))
+	for i, family := range c.Families {
+		families[i] = family + ".*"
+	}
+	return fmt.Sprintf("%s is returned by %s methods.", c.Name, strings.Join(families, ", "))
+}
+
+type group struct {
+	Heading string
+	Ident   string
+	Codes   []errorCode
+}
+
+func main() {
+	in := flag.String("in", "error_codes.csv", "error codes `file`")
+	out := flag.String("out", "error_codes_gen.go", "generated `file`")
+	flag.Parse()
+
+	f, err := os.Open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	src, err := generate(f)
+	if err != nil {
+		log.Fatalf("%s: %v", *in, err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the Go source declaring the error codes read from r.
+func generate(r io.Reader) ([]byte, error) {
+	codes, err := readCodes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []group
+	for _, class := range classes {
+		g := group{Heading: class.Heading, Ident: class.Ident}
+		for _, code := range codes {
+			if code.Class == class.Name {
+				g.Codes = append(g.Codes, code)
+			}
+		}
+		if len(g.Codes) > 0 {
+			groups = append(groups, g)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, groups); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+func readCodes(r io.Reader) ([]errorCode, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = 4
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("missing header")
+	}
+
+	names := map[string]bool{}
+	codes := map[string]bool{}
+	var result []errorCode
+	for _, record := range records[1:] {
+		code := errorCode{
+			Name:     record[0],
+			Code:     record[1],
+			Class:    record[2],
+			Families: strings.Fields(record[3]),
+		}
+		switch {
+		case !strings.HasPrefix(code.Name, "Err"):
+			return nil, fmt.Errorf("%s: name must start with Err", code.Name)
+		case names[code.Name]:
+			return nil, fmt.Errorf("%s: duplicate name", code.Name)
+		case codes[code.Code]:
+			return nil, fmt.Errorf("%s: duplicate code", code.Code)
+		case !slices.ContainsFunc(classes, func(c class) bool { return c.Name == code.Class }):
+			return nil, fmt.Errorf("%s: unknown class %q", code.Name, code.Class)
+		case len(code.Families) == 0:
+			return nil, fmt.Errorf("%s: missing API families", code.Name)
+		}
+		names[code.Name] = true
+		codes[code.Code] = true
+		result = append(result, code)
+	}
+	return result, nil
+}
+
+var tmpl = template.Must(template.New("").Parse(`// Code generated by internal/errorcodegen from internal/errorcodegen/error_codes.csv. DO NOT EDIT.
+
+package slack
+{{range .}}
+// {{.Heading}}
+const (
+{{- range .Codes}}
+	// {{.Doc}}
+	{{.Name}} ErrorCode = "{{.Code}}"
+{{- end}}
+)
+{{end}}
+var errorCodeClasses = map[ErrorCode]ErrorClass{
+{{- range $i, $g := .}}{{if $i}}
+{{end}}
+{{- range .Codes}}
+	{{.Name}}: {{$g.Ident}},
+{{- end}}
+{{- end}}
+}
+`))
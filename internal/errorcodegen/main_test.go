@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedFileUpToDate(t *testing.T) {
+	f, err := os.Open("error_codes.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	want, err := generate(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile("../../error_codes_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("error_codes_gen.go is out of date, run go generate in the repository root")
+	}
+}
+
+func TestGenerateRejectsInvalidRows(t *testing.T) {
+	tests := map[string]string{
+		"bad name":         "NotFound,not_found,not_found,*",
+		"duplicate name":   "ErrNotFound,not_found,not_found,*\nErrNotFound,other,not_found,*",
+		"duplicate code":   "ErrNotFound,not_found,not_found,*\nErrOther,not_found,not_found,*",
+		"unknown class":    "ErrNotFound,not_found,missing,*",
+		"missing families": "ErrNotFound,not_found,not_found,",
+	}
+	for name, rows := range tests {
+		if _, err := generate(strings.NewReader("name,code,class,families\n" + rows)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
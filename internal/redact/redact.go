@@ -0,0 +1,25 @@
+// Package redact removes secrets from logged messages.
+package redact
+
+import "regexp"
+
+// See https://api.slack.com/authentication/token-types
+// and https://api.slack.com/authentication/rotation
+var (
+	tokenPattern  = regexp.MustCompile(`((?:token=|"token":\s*"|Bearer )x[a-z.]+)-[0-9A-Za-z-]+`)
+	ticketPattern = regexp.MustCompile(`(ticket=)[^&\s"]+`)
+)
+
+// Bytes redacts tokens sent as a form value, a JSON field or a bearer
+// token, and the ticket of Socket Mode connection URLs.
+func Bytes(b []byte) []byte {
+	// Keep the prefix and the first element of the token, which identifies its type
+	// (this could be useful for debugging, e.g. when using a wrong token).
+	b = tokenPattern.ReplaceAll(b, []byte("$1-REDACTED"))
+	return ticketPattern.ReplaceAll(b, []byte("${1}REDACTED"))
+}
+
+// String is like Bytes for a string.
+func String(s string) string {
+	return string(Bytes([]byte(s)))
+}
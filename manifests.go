@@ -39,7 +39,7 @@ func (api *Client) CreateManifestContext(ctx context.Context, manifest *Manifest
 	}
 
 	response := &ManifestResponse{}
-	err = api.postMethod(ctx, "apps.manifest.create", values, response)
+	err = api.postMethodWithExplicitToken(ctx, "apps.manifest.create", token, values, response)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +66,7 @@ func (api *Client) DeleteManifestContext(ctx context.Context, token string, appI
 	}
 
 	response := &SlackResponse{}
-	err := api.postMethod(ctx, "apps.manifest.delete", values, response)
+	err := api.postMethodWithExplicitToken(ctx, "apps.manifest.delete", token, values, response)
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +93,7 @@ func (api *Client) ExportManifestContext(ctx context.Context, token string, appI
 	}
 
 	response := &ExportManifestResponse{}
-	err := api.postMethod(ctx, "apps.manifest.export", values, response)
+	err := api.postMethodWithExplicitToken(ctx, "apps.manifest.export", token, values, response)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +126,7 @@ func (api *Client) UpdateManifestContext(ctx context.Context, manifest *Manifest
 	}
 
 	response := &UpdateManifestResponse{}
-	err = api.postMethod(ctx, "apps.manifest.update", values, response)
+	err = api.postMethodWithExplicitToken(ctx, "apps.manifest.update", token, values, response)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +163,7 @@ func (api *Client) ValidateManifestContext(ctx context.Context, manifest *Manife
 	}
 
 	response := &ManifestResponse{}
-	err = api.postMethod(ctx, "apps.manifest.validate", values, response)
+	err = api.postMethodWithExplicitToken(ctx, "apps.manifest.validate", token, values, response)
 	if err != nil {
 		return nil, err
 	}
@@ -27,7 +27,7 @@ func (api *Client) MigrationExchange(ctx context.Context, teamID string, toOld b
 	}
 
 	response := &migrationExchangeResponseFull{}
-	err := api.getMethod(ctx, "migration.exchange", api.token, values, response)
+	err := api.getMethod(ctx, "migration.exchange", values, response)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -112,11 +112,24 @@ func (e *SlackResponseErrors) UnmarshalJSON(data []byte) error {
 
 // SlackResponse handles parsing out errors from the web api.
 type SlackResponse struct {
-	Ok               bool                  `json:"ok"`
-	Error            string                `json:"error"`
-	Warning          string                `json:"warning"`
-	Errors           []SlackResponseErrors `json:"errors,omitempty"`
-	ResponseMetadata ResponseMetadata      `json:"response_metadata"`
+	Ok      bool                  `json:"ok"`
+	Error   string                `json:"error"`
+	Warning string                `json:"warning"`
+	Errors  []SlackResponseErrors `json:"errors,omitempty"`
+	// Needed and Provided are set on missing_scope errors.
+	Needed           string           `json:"needed,omitempty"`
+	Provided         string           `json:"provided,omitempty"`
+	ResponseMetadata ResponseMetadata `json:"response_metadata"`
+
+	// method and requestID are recorded for errors.
+	method    string
+	requestID string
+}
+
+func (t *SlackResponse) setCallInfo(method, requestID string) {
+	if !t.Ok && t.Error != "" {
+		t.method, t.requestID = method, requestID
+	}
 }
 
 // Warn returns warning information from the API response, or nil if there
@@ -247,18 +260,61 @@ func (t SlackResponse) Err() error {
 		return nil
 	}
 
-	return SlackErrorResponse{Err: t.Error, Errors: t.Errors, ResponseMetadata: t.ResponseMetadata}
+	return SlackErrorResponse{
+		Err:              t.Error,
+		Errors:           t.Errors,
+		Needed:           t.Needed,
+		Provided:         t.Provided,
+		ResponseMetadata: t.ResponseMetadata,
+		method:           t.method,
+		requestID:        t.requestID,
+	}
 }
 
 // SlackErrorResponse brings along the metadata of errors returned by the Slack API.
 type SlackErrorResponse struct {
-	Err              string
-	Errors           []SlackResponseErrors
+	Err    string
+	Errors []SlackResponseErrors
+	// Needed and Provided are the comma separated scopes reported by
+	// missing_scope errors.
+	Needed   string
+	Provided string
+	// ResponseMetadata.Messages often details which argument was rejected.
 	ResponseMetadata ResponseMetadata
+
+	// method and requestID are recorded for errors.
+	method    string
+	requestID string
 }
 
 func (r SlackErrorResponse) Error() string { return r.Err }
 
+// Is reports whether target is the ErrorCode of the error, so that
+// errors.Is(err, ErrChannelNotFound) works.
+func (r SlackErrorResponse) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && string(code) == r.Err
+}
+
+// Code returns the error code.
+func (r SlackErrorResponse) Code() ErrorCode { return ErrorCode(r.Err) }
+
+// Method returns the API method that failed, when known.
+func (r SlackErrorResponse) Method() string { return r.method }
+
+// RequestID returns the X-Slack-Req-Id of the response, when known.
+func (r SlackErrorResponse) RequestID() string { return r.requestID }
+
+// Class returns the classification of the error code.
+func (r SlackErrorResponse) Class() ErrorClass { return r.Code().Class() }
+
+// NeededScopes returns the scopes a missing_scope error asked for.
+func (r SlackErrorResponse) NeededScopes() []string { return splitScopes(r.Needed) }
+
+// ProvidedScopes returns the scopes a missing_scope error reported as
+// granted to the token.
+func (r SlackErrorResponse) ProvidedScopes() []string { return splitScopes(r.Provided) }
+
 // RateLimitedError represents the rate limit response from slack
 type RateLimitedError struct {
 	RetryAfter time.Duration
@@ -272,6 +328,11 @@ func (e *RateLimitedError) Retryable() bool {
 	return true
 }
 
+// Is reports whether target is ErrRatelimited.
+func (e *RateLimitedError) Is(target error) bool {
+	return target == ErrRatelimited
+}
+
 func fileUploadReq(ctx context.Context, path string, r io.Reader) (*http.Request, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, path, r)
 	if err != nil {
@@ -467,7 +528,9 @@ func getResource(ctx context.Context, client httpClient, endpoint, token string,
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	if token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	}
 
 	req.URL.RawQuery = values.Encode()
 
@@ -528,7 +591,11 @@ func newJSONParser(dst any) responseParser {
 		if hs, ok := dst.(httpHeaderSetter); ok {
 			hs.setHTTPResponseHeaders(resp.Header.Clone())
 		}
-		return json.NewDecoder(resp.Body).Decode(dst)
+		if err := json.NewDecoder(resp.Body).Decode(dst); err != nil {
+			return err
+		}
+		setCallInfo(dst, resp)
+		return nil
 	}
 }
 
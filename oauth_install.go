@@ -0,0 +1,429 @@
+package slack
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// OAuthV2AuthorizeURL is the Slack page users are sent to when installing an app.
+	OAuthV2AuthorizeURL = "https://slack.com/oauth/v2/authorize"
+	// OAuthStateCookieName is the cookie binding an OAuth state to the browser
+	// that started the installation.
+	OAuthStateCookieName = "slack-app-oauth-state"
+	// DefaultOAuthStateTTL is the lifetime of the state cookie when neither
+	// OAuthInstaller.StateTTL nor the state store sets it.
+	DefaultOAuthStateTTL = 10 * time.Minute
+)
+
+// InstallationFromOAuthV2Response converts the result of an oauth.v2.access
+// exchange into an Installation. now is used to compute token expiry times
+// for apps with token rotation enabled.
+func InstallationFromOAuthV2Response(resp *OAuthV2Response, now time.Time) *Installation {
+	installation := &Installation{
+		AppID:               resp.AppID,
+		EnterpriseID:        resp.Enterprise.ID,
+		EnterpriseName:      resp.Enterprise.Name,
+		TeamID:              resp.Team.ID,
+		TeamName:            resp.Team.Name,
+		IsEnterpriseInstall: resp.IsEnterpriseInstall,
+		UserID:              resp.AuthedUser.ID,
+		IncomingWebhook:     resp.IncomingWebhook,
+		InstalledAt:         now,
+	}
+
+	if resp.TokenType == "bot" || resp.BotUserID != "" {
+		installation.BotToken = resp.AccessToken
+		installation.BotUserID = resp.BotUserID
+		installation.BotScopes = resp.Scope
+		installation.BotRefreshToken = resp.RefreshToken
+		if resp.ExpiresIn > 0 {
+			installation.BotTokenExpiresAt = now.Add(time.Duration(resp.ExpiresIn) * time.Second)
+		}
+	}
+
+	if resp.AuthedUser.AccessToken != "" {
+		installation.UserToken = resp.AuthedUser.AccessToken
+		installation.UserScopes = resp.AuthedUser.Scope
+		installation.UserRefreshToken = resp.AuthedUser.RefreshToken
+		if resp.AuthedUser.ExpiresIn > 0 {
+			installation.UserTokenExpiresAt = now.Add(time.Duration(resp.AuthedUser.ExpiresIn) * time.Second)
+		}
+	}
+
+	return installation
+}
+
+// OAuthStateStore issues and validates the state parameter of the OAuth
+// flow. Implementations must make states single use.
+type OAuthStateStore interface {
+	// IssueState returns a new unguessable state and remembers data (the
+	// PKCE code verifier, if any) for it.
+	IssueState(ctx context.Context, data string) (state string, err error)
+	// ConsumeState returns the data remembered for state and invalidates
+	// it. It returns ErrOAuthStateInvalid for unknown or expired states.
+	ConsumeState(ctx context.Context, state string) (data string, err error)
+}
+
+type memoryOAuthState struct {
+	data      string
+	expiresAt time.Time
+}
+
+// MemoryOAuthStateStore is an OAuthStateStore keeping states in memory. It
+// only works when the install and redirect requests reach the same process.
+type MemoryOAuthStateStore struct {
+	ttl    time.Duration
+	mu     sync.Mutex
+	states map[string]memoryOAuthState
+	now    func() time.Time
+}
+
+// NewMemoryOAuthStateStore returns a MemoryOAuthStateStore whose states
+// expire after ttl.
+func NewMemoryOAuthStateStore(ttl time.Duration) *MemoryOAuthStateStore {
+	return &MemoryOAuthStateStore{
+		ttl:    ttl,
+		states: make(map[string]memoryOAuthState),
+		now:    time.Now,
+	}
+}
+
+// IssueState implements OAuthStateStore.
+func (s *MemoryOAuthStateStore) IssueState(ctx context.Context, data string) (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	state := base64.RawURLEncoding.EncodeToString(b)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for k, v := range s.states {
+		if now.After(v.expiresAt) {
+			delete(s.states, k)
+		}
+	}
+	s.states[state] = memoryOAuthState{data: data, expiresAt: now.Add(s.ttl)}
+	return state, nil
+}
+
+// TTL returns the lifetime of the states issued by the store.
+func (s *MemoryOAuthStateStore) TTL() time.Duration {
+	return s.ttl
+}
+
+// ConsumeState implements OAuthStateStore.
+func (s *MemoryOAuthStateStore) ConsumeState(ctx context.Context, state string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.states[state]
+	if !ok {
+		return "", ErrOAuthStateInvalid
+	}
+	delete(s.states, state)
+	if s.now().After(v.expiresAt) {
+		return "", ErrOAuthStateInvalid
+	}
+	return v.data, nil
+}
+
+// OAuthInstaller implements the "Add to Slack" OAuth v2 installation flow.
+// Mount InstallHandler on /slack/install and RedirectHandler on the path of
+// RedirectURI, typically /slack/oauth_redirect:
+//
+//	installer := &slack.OAuthInstaller{
+//		ClientID:          os.Getenv("SLACK_CLIENT_ID"),
+//		ClientSecret:      os.Getenv("SLACK_CLIENT_SECRET"),
+//		Scopes:            []string{"chat:write", "commands"},
+//		RedirectURI:       "https://example.com/slack/oauth_redirect",
+//		InstallationStore: store,
+//		StateStore:        slack.NewMemoryOAuthStateStore(10 * time.Minute),
+//	}
+//	install, err := installer.InstallHandler()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	redirect, err := installer.RedirectHandler()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	http.Handle("/slack/install", install)
+//	http.Handle("/slack/oauth_redirect", redirect)
+type OAuthInstaller struct {
+	ClientID string
+	// ClientSecret may be empty for public clients using PKCE.
+	ClientSecret string
+	Scopes       []string
+	UserScopes   []string
+	// RedirectURI is optional when a single redirect URL is configured for
+	// the app.
+	RedirectURI string
+	// Team restricts the installation to a workspace.
+	Team string
+	// UsePKCE adds a S256 code challenge to the authorize URL and sends the
+	// matching verifier when exchanging the code.
+	UsePKCE bool
+
+	InstallationStore InstallationStore
+	StateStore        OAuthStateStore
+	// StateTTL is the lifetime of the state cookie. It defaults to the TTL
+	// of StateStore when it has a TTL method, like MemoryOAuthStateStore,
+	// and to DefaultOAuthStateTTL otherwise.
+	StateTTL time.Duration
+
+	// AuthorizeEndpoint defaults to OAuthV2AuthorizeURL.
+	AuthorizeEndpoint string
+	// HTTPClient is used for the oauth.v2.access exchange and defaults to
+	// http.DefaultClient.
+	HTTPClient httpClient
+	// OAuthOptions are passed to GetOAuthV2ResponseContext.
+	OAuthOptions []OAuthOption
+
+	// RenderSuccess and RenderFailure replace the default result pages.
+	RenderSuccess func(w http.ResponseWriter, r *http.Request, installation *Installation)
+	RenderFailure func(w http.ResponseWriter, r *http.Request, err error)
+	// Logger receives the errors behind the default failure page, which
+	// does not show them. It defaults to slog.Default().
+	Logger *slog.Logger
+	// OnInstall is called after the installation has been saved.
+	OnInstall func(ctx context.Context, installation *Installation)
+}
+
+// AuthorizeURL returns the URL of the Slack authorization page for state.
+// codeChallenge is only added when not empty.
+func (o *OAuthInstaller) AuthorizeURL(state, codeChallenge string) string {
+	values := url.Values{
+		"client_id": {o.ClientID},
+		"scope":     {strings.Join(o.Scopes, ",")},
+		"state":     {state},
+	}
+	if len(o.UserScopes) > 0 {
+		values.Set("user_scope", strings.Join(o.UserScopes, ","))
+	}
+	if o.RedirectURI != "" {
+		values.Set("redirect_uri", o.RedirectURI)
+	}
+	if o.Team != "" {
+		values.Set("team", o.Team)
+	}
+	if codeChallenge != "" {
+		values.Set("code_challenge", codeChallenge)
+		values.Set("code_challenge_method", "S256")
+	}
+
+	endpoint := o.AuthorizeEndpoint
+	if endpoint == "" {
+		endpoint = OAuthV2AuthorizeURL
+	}
+	return endpoint + "?" + values.Encode()
+}
+
+// validate reports the stores the handlers need but are not set.
+func (o *OAuthInstaller) validate() error {
+	if o.StateStore == nil {
+		return errors.New("slack: OAuthInstaller.StateStore is not set")
+	}
+	if o.InstallationStore == nil {
+		return errors.New("slack: OAuthInstaller.InstallationStore is not set")
+	}
+	return nil
+}
+
+// stateTTL returns the lifetime of the state cookie.
+func (o *OAuthInstaller) stateTTL() time.Duration {
+	if o.StateTTL > 0 {
+		return o.StateTTL
+	}
+	if store, ok := o.StateStore.(interface{ TTL() time.Duration }); ok && store.TTL() > 0 {
+		return store.TTL()
+	}
+	return DefaultOAuthStateTTL
+}
+
+// InstallHandler returns the handler starting an installation. It issues a
+// state, binds it to the browser with a cookie and redirects to Slack. It
+// returns an error if StateStore or InstallationStore is not set.
+func (o *OAuthInstaller) InstallHandler() (http.Handler, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var verifier, challenge string
+		if o.UsePKCE {
+			var err error
+			if verifier, err = GenerateCodeVerifier(); err != nil {
+				o.renderFailure(w, r, err)
+				return
+			}
+			challenge = GenerateCodeChallenge(verifier)
+		}
+
+		state, err := o.StateStore.IssueState(r.Context(), verifier)
+		if err != nil {
+			o.renderFailure(w, r, err)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     OAuthStateCookieName,
+			Value:    state,
+			Path:     "/",
+			MaxAge:   int(o.stateTTL().Seconds()),
+			HttpOnly: true,
+			Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+			SameSite: http.SameSiteLaxMode,
+		})
+		http.Redirect(w, r, o.AuthorizeURL(state, challenge), http.StatusFound)
+	}), nil
+}
+
+// RedirectHandler returns the handler Slack redirects to after the user
+// approved or cancelled the installation. It validates the state, exchanges
+// the code, saves the installation and renders the result page. It returns
+// an error if StateStore or InstallationStore is not set.
+func (o *OAuthInstaller) RedirectHandler() (http.Handler, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		installation, err := o.complete(r)
+		http.SetCookie(w, &http.Cookie{Name: OAuthStateCookieName, Path: "/", MaxAge: -1})
+		if err != nil {
+			o.renderFailure(w, r, err)
+			return
+		}
+		if o.OnInstall != nil {
+			o.OnInstall(r.Context(), installation)
+		}
+		o.renderSuccess(w, r, installation)
+	}), nil
+}
+
+// OAuthError is the error Slack reports on the redirect when the user
+// cancelled the installation or it failed on Slack's side.
+type OAuthError struct {
+	Code string
+}
+
+func (e OAuthError) Error() string {
+	return "oauth error: " + e.Code
+}
+
+func (o *OAuthInstaller) complete(r *http.Request) (*Installation, error) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	if code := query.Get("error"); code != "" {
+		return nil, OAuthError{Code: code}
+	}
+
+	state := query.Get("state")
+	cookie, err := r.Cookie(OAuthStateCookieName)
+	if state == "" || err != nil || cookie.Value != state {
+		return nil, ErrOAuthStateInvalid
+	}
+	verifier, err := o.StateStore.ConsumeState(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	code := query.Get("code")
+	if code == "" {
+		return nil, ErrParametersMissing
+	}
+
+	client := o.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	opts := o.OAuthOptions
+	if verifier != "" {
+		opts = append(opts[:len(opts):len(opts)], OAuthOptionCodeVerifier(verifier))
+	}
+	resp, err := GetOAuthV2ResponseContext(ctx, client, o.ClientID, o.ClientSecret, code, o.RedirectURI, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	installation := InstallationFromOAuthV2Response(resp, time.Now())
+	if err := o.InstallationStore.SaveInstallation(ctx, installation); err != nil {
+		return nil, fmt.Errorf("saving installation: %w", err)
+	}
+	return installation, nil
+}
+
+var (
+	oauthSuccessPage = template.Must(template.New("success").Parse(`<!DOCTYPE html>
+<html><head><title>Installation successful</title></head>
+<body><h2>Thank you!</h2>
+<p>The app has been installed{{with .TeamName}} in {{.}}{{end}}. <a href="{{.URL}}">Open Slack</a>.</p>
+</body></html>
+`))
+	oauthFailurePage = template.Must(template.New("failure").Parse(`<!DOCTYPE html>
+<html><head><title>Installation failed</title></head>
+<body><h2>Oops, something went wrong</h2>
+<p>{{.}} <a href="/slack/install">Try again</a>.</p>
+</body></html>
+`))
+)
+
+func (o *OAuthInstaller) renderSuccess(w http.ResponseWriter, r *http.Request, installation *Installation) {
+	if o.RenderSuccess != nil {
+		o.RenderSuccess(w, r, installation)
+		return
+	}
+
+	teamID := installation.TeamID
+	if installation.IsEnterpriseInstall {
+		teamID = installation.EnterpriseID
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	oauthSuccessPage.Execute(w, struct {
+		TeamName string
+		URL      template.URL
+	}{
+		TeamName: installation.TeamName,
+		URL:      template.URL("slack://app?" + url.Values{"team": {teamID}, "id": {installation.AppID}}.Encode()),
+	})
+}
+
+func (o *OAuthInstaller) renderFailure(w http.ResponseWriter, r *http.Request, err error) {
+	if o.RenderFailure != nil {
+		o.RenderFailure(w, r, err)
+		return
+	}
+
+	logger := o.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	// The error may come from the stores or the token exchange, so it is
+	// logged rather than shown.
+	status := http.StatusInternalServerError
+	message := "The app could not be installed."
+	var oauthErr OAuthError
+	if errors.Is(err, ErrOAuthStateInvalid) || errors.Is(err, ErrParametersMissing) || errors.As(err, &oauthErr) {
+		status = http.StatusBadRequest
+		message = "The installation was cancelled or has expired."
+		logger.WarnContext(r.Context(), "slack app installation rejected", slog.Any("error", err))
+	} else {
+		logger.ErrorContext(r.Context(), "slack app installation failed", slog.Any("error", err))
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	oauthFailurePage.Execute(w, message)
+}
@@ -0,0 +1,123 @@
+package otelslack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/slack-go/slack"
+)
+
+// Attribute keys set on Web API metrics and spans. ChannelKey is only set on
+// spans, to keep the cardinality of metrics bounded.
+const (
+	MethodKey     = attribute.Key("slack.method")
+	TeamIDKey     = attribute.Key("slack.team.id")
+	ChannelKey    = attribute.Key("slack.channel.id")
+	OKKey         = attribute.Key("slack.ok")
+	ErrorCodeKey  = attribute.Key("slack.error")
+	RetriesKey    = attribute.Key("slack.retries")
+	RetryAfterKey = attribute.Key("slack.retry_after")
+)
+
+// APIObserver is a slack.APIObserver recording a client span for every Web
+// API request, along with call, retry and latency metrics.
+type APIObserver struct {
+	tracer trace.Tracer
+
+	calls    metric.Int64Counter
+	retries  metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+var _ slack.APIObserver = (*APIObserver)(nil)
+
+// NewAPIObserver creates the Web API instruments.
+func NewAPIObserver(options ...Option) (*APIObserver, error) {
+	c := newConfig(options)
+	meter := c.meterProvider.Meter(ScopeName)
+
+	o := &APIObserver{tracer: c.tracerProvider.Tracer(ScopeName)}
+	var err, e error
+	o.calls, e = meter.Int64Counter("slack.api.calls",
+		metric.WithDescription("Web API requests, by method and outcome."))
+	err = errors.Join(err, e)
+	o.retries, e = meter.Int64Counter("slack.api.retries",
+		metric.WithDescription("Web API requests retried after a rate limit or server error."))
+	err = errors.Join(err, e)
+	o.duration, e = meter.Float64Histogram("slack.api.duration",
+		metric.WithDescription("Duration of Web API requests, retries included."), metric.WithUnit("s"))
+	err = errors.Join(err, e)
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// StartCall starts a span named after the API method.
+func (o *APIObserver) StartCall(ctx context.Context, method string) context.Context {
+	ctx, _ = o.tracer.Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(MethodKey.String(method)),
+	)
+	return ctx
+}
+
+// EndCall ends the span started by StartCall and records the metrics.
+func (o *APIObserver) EndCall(ctx context.Context, call slack.APICall) {
+	attrs := []attribute.KeyValue{
+		MethodKey.String(call.Method),
+		OKKey.Bool(call.OK),
+	}
+	if call.TeamID != "" {
+		attrs = append(attrs, TeamIDKey.String(call.TeamID))
+	}
+	if call.ErrorCode != "" {
+		attrs = append(attrs, ErrorCodeKey.String(call.ErrorCode))
+	}
+
+	o.calls.Add(ctx, 1, metric.WithAttributes(attrs...))
+	o.duration.Record(ctx, call.Duration.Seconds(), metric.WithAttributes(attrs...))
+	if call.Retries > 0 {
+		o.retries.Add(ctx, int64(call.Retries), metric.WithAttributes(MethodKey.String(call.Method)))
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attrs...)
+	span.SetAttributes(RetriesKey.Int(call.Retries))
+	if call.Channel != "" {
+		span.SetAttributes(ChannelKey.String(call.Channel))
+	}
+	if call.RetryAfter > 0 {
+		span.SetAttributes(RetryAfterKey.Float64(call.RetryAfter.Seconds()))
+	}
+	span.SetAttributes(rateLimitHeaders(call.Header)...)
+
+	switch {
+	case call.Err != nil:
+		span.RecordError(call.Err)
+		span.SetStatus(codes.Error, call.Err.Error())
+	case !call.OK:
+		span.SetStatus(codes.Error, call.ErrorCode)
+	}
+	span.End()
+}
+
+// rateLimitHeaders returns the Retry-After and X-Ratelimit-* response
+// headers as http.response.header.* attributes.
+func rateLimitHeaders(header http.Header) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	for name, values := range header {
+		lower := strings.ToLower(name)
+		if lower == "retry-after" || strings.HasPrefix(lower, "x-ratelimit-") {
+			attrs = append(attrs, attribute.StringSlice("http.response.header."+lower, values))
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,64 @@
+package otelslack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/slack-go/slack"
+)
+
+func TestAPIObserver(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": false, "error": "not_in_channel"}`))
+	})
+	mux.HandleFunc("/users.info", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("X-Ratelimit-Remaining", "19")
+		rw.Write([]byte(`{"ok": true, "user": {"id": "U1"}}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	reader := sdkmetric.NewManualReader()
+	spans := tracetest.NewSpanRecorder()
+	o, err := NewAPIObserver(
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+	)
+	require.NoError(t, err)
+	api := slack.New("xoxb-test", slack.OptionAPIURL(server.URL+"/"), slack.OptionAPIObserver(o))
+
+	_, _, err = api.PostMessage("C1", slack.MsgOptionText("hi", false))
+	assert.Error(t, err)
+	_, err = api.GetUserInfo("U1")
+	require.NoError(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 2)
+	assert.Equal(t, "chat.postMessage", ended[0].Name())
+	assert.Equal(t, codes.Error, ended[0].Status().Code)
+	assert.Contains(t, ended[0].Attributes(), ChannelKey.String("C1"))
+	assert.Contains(t, ended[0].Attributes(), ErrorCodeKey.String("not_in_channel"))
+	assert.Equal(t, "users.info", ended[1].Name())
+	assert.Equal(t, codes.Unset, ended[1].Status().Code)
+	assert.Contains(t, ended[1].Attributes(), attribute.StringSlice("http.response.header.x-ratelimit-remaining", []string{"19"}))
+
+	metrics := collect(t, reader)
+	assert.EqualValues(t, 1, sumOf(t, metrics["slack.api.calls"], MethodKey.String("chat.postMessage"), OKKey.Bool(false), ErrorCodeKey.String("not_in_channel")))
+	assert.EqualValues(t, 1, sumOf(t, metrics["slack.api.calls"], MethodKey.String("users.info"), OKKey.Bool(true)))
+	histogram, ok := metrics["slack.api.duration"].(metricdata.Histogram[float64])
+	require.True(t, ok)
+	assert.Len(t, histogram.DataPoints, 2)
+}
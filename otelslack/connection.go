@@ -0,0 +1,131 @@
+package otelslack
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/slack-go/slack"
+)
+
+// Attribute keys set on connection metrics and spans.
+const (
+	ConnectionKey      = attribute.Key("slack.connection")
+	EventTypeKey       = attribute.Key("slack.event.type")
+	ReconnectReasonKey = attribute.Key("slack.reconnect.reason")
+	DropReasonKey      = attribute.Key("slack.drop.reason")
+	SuccessKey         = attribute.Key("slack.success")
+)
+
+// ConnectionObserver is a slack.ConnectionObserver recording metrics, and a
+// span for every Socket Mode handler run.
+type ConnectionObserver struct {
+	tracer trace.Tracer
+
+	attempts        metric.Int64Counter
+	reconnects      metric.Int64Counter
+	pingLatency     metric.Float64Histogram
+	events          metric.Int64Counter
+	ackLatency      metric.Float64Histogram
+	handlerDuration metric.Float64Histogram
+	dropped         metric.Int64Counter
+}
+
+var _ slack.ConnectionObserver = (*ConnectionObserver)(nil)
+
+// NewConnectionObserver creates the connection instruments.
+func NewConnectionObserver(options ...Option) (*ConnectionObserver, error) {
+	c := newConfig(options)
+	meter := c.meterProvider.Meter(ScopeName)
+
+	o := &ConnectionObserver{tracer: c.tracerProvider.Tracer(ScopeName)}
+	var err, e error
+	o.attempts, e = meter.Int64Counter("slack.connection.attempts",
+		metric.WithDescription("Attempts to open a WebSocket connection."))
+	err = errors.Join(err, e)
+	o.reconnects, e = meter.Int64Counter("slack.connection.reconnects",
+		metric.WithDescription("WebSocket connections replaced, by reason."))
+	err = errors.Join(err, e)
+	o.pingLatency, e = meter.Float64Histogram("slack.connection.ping.latency",
+		metric.WithDescription("Round trip of pings sent by the client."), metric.WithUnit("s"))
+	err = errors.Join(err, e)
+	o.events, e = meter.Int64Counter("slack.connection.events",
+		metric.WithDescription("Messages received, by event type."))
+	err = errors.Join(err, e)
+	o.ackLatency, e = meter.Float64Histogram("slack.connection.ack.latency",
+		metric.WithDescription("Time until an envelope or outgoing message is acknowledged."), metric.WithUnit("s"))
+	err = errors.Join(err, e)
+	o.handlerDuration, e = meter.Float64Histogram("slack.connection.handler.duration",
+		metric.WithDescription("Duration of event handlers."), metric.WithUnit("s"))
+	err = errors.Join(err, e)
+	o.dropped, e = meter.Int64Counter("slack.connection.responses.dropped",
+		metric.WithDescription("Responses and outgoing messages that could not be sent, by reason."))
+	err = errors.Join(err, e)
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func (o *ConnectionObserver) ConnectAttempt(connection slack.ConnectionType, attempt int, err error) {
+	o.attempts.Add(context.Background(), 1, metric.WithAttributes(
+		ConnectionKey.String(string(connection)),
+		SuccessKey.Bool(err == nil),
+	))
+}
+
+func (o *ConnectionObserver) Reconnecting(connection slack.ConnectionType, reason string) {
+	o.reconnects.Add(context.Background(), 1, metric.WithAttributes(
+		ConnectionKey.String(string(connection)),
+		ReconnectReasonKey.String(reason),
+	))
+}
+
+func (o *ConnectionObserver) PingLatency(connection slack.ConnectionType, latency time.Duration) {
+	o.pingLatency.Record(context.Background(), latency.Seconds(), metric.WithAttributes(
+		ConnectionKey.String(string(connection)),
+	))
+}
+
+func (o *ConnectionObserver) EventReceived(connection slack.ConnectionType, eventType string) {
+	o.events.Add(context.Background(), 1, metric.WithAttributes(
+		ConnectionKey.String(string(connection)),
+		EventTypeKey.String(eventType),
+	))
+}
+
+func (o *ConnectionObserver) Acked(connection slack.ConnectionType, eventType string, latency time.Duration) {
+	o.ackLatency.Record(context.Background(), latency.Seconds(), metric.WithAttributes(
+		ConnectionKey.String(string(connection)),
+		EventTypeKey.String(eventType),
+	))
+}
+
+// HandlerDone records the handler duration and a span covering the handler
+// run.
+func (o *ConnectionObserver) HandlerDone(connection slack.ConnectionType, eventType string, duration time.Duration) {
+	attrs := []attribute.KeyValue{
+		ConnectionKey.String(string(connection)),
+		EventTypeKey.String(eventType),
+	}
+	o.handlerDuration.Record(context.Background(), duration.Seconds(), metric.WithAttributes(attrs...))
+
+	end := time.Now()
+	_, span := o.tracer.Start(context.Background(), string(connection)+" "+eventType,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithTimestamp(end.Add(-duration)),
+		trace.WithAttributes(attrs...),
+	)
+	span.End(trace.WithTimestamp(end))
+}
+
+func (o *ConnectionObserver) ResponseDropped(connection slack.ConnectionType, reason string) {
+	o.dropped.Add(context.Background(), 1, metric.WithAttributes(
+		ConnectionKey.String(string(connection)),
+		DropReasonKey.String(reason),
+	))
+}
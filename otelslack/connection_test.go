@@ -0,0 +1,84 @@
+package otelslack
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/slack-go/slack"
+)
+
+func collect(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	metrics := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+	return metrics
+}
+
+func sumOf(t *testing.T, data metricdata.Aggregation, attrs ...attribute.KeyValue) int64 {
+	t.Helper()
+	sum, ok := data.(metricdata.Sum[int64])
+	require.True(t, ok, "not an int64 sum: %T", data)
+	set := attribute.NewSet(attrs...)
+	for _, point := range sum.DataPoints {
+		if point.Attributes.Equals(&set) {
+			return point.Value
+		}
+	}
+	return 0
+}
+
+func TestConnectionObserver(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	spans := tracetest.NewSpanRecorder()
+	o, err := NewConnectionObserver(
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+	)
+	require.NoError(t, err)
+
+	o.ConnectAttempt(slack.ConnectionTypeSocketMode, 1, errors.New("refused"))
+	o.ConnectAttempt(slack.ConnectionTypeSocketMode, 2, nil)
+	o.Reconnecting(slack.ConnectionTypeRTM, slack.ReconnectReasonGoodbye)
+	o.PingLatency(slack.ConnectionTypeSocketMode, 30*time.Millisecond)
+	o.EventReceived(slack.ConnectionTypeSocketMode, "events_api")
+	o.Acked(slack.ConnectionTypeSocketMode, "events_api", time.Millisecond)
+	o.HandlerDone(slack.ConnectionTypeSocketMode, "events_api", 2*time.Second)
+	o.ResponseDropped(slack.ConnectionTypeSocketMode, slack.DropReasonOversized)
+
+	metrics := collect(t, reader)
+	socketMode := ConnectionKey.String("socketmode")
+	assert.EqualValues(t, 1, sumOf(t, metrics["slack.connection.attempts"], socketMode, SuccessKey.Bool(false)))
+	assert.EqualValues(t, 1, sumOf(t, metrics["slack.connection.attempts"], socketMode, SuccessKey.Bool(true)))
+	assert.EqualValues(t, 1, sumOf(t, metrics["slack.connection.reconnects"], ConnectionKey.String("rtm"), ReconnectReasonKey.String("goodbye")))
+	assert.EqualValues(t, 1, sumOf(t, metrics["slack.connection.events"], socketMode, EventTypeKey.String("events_api")))
+	assert.EqualValues(t, 1, sumOf(t, metrics["slack.connection.responses.dropped"], socketMode, DropReasonKey.String("oversized")))
+
+	for _, name := range []string{"slack.connection.ping.latency", "slack.connection.ack.latency", "slack.connection.handler.duration"} {
+		histogram, ok := metrics[name].(metricdata.Histogram[float64])
+		if assert.True(t, ok, name) && assert.Len(t, histogram.DataPoints, 1, name) {
+			assert.EqualValues(t, 1, histogram.DataPoints[0].Count, name)
+		}
+	}
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+	assert.Equal(t, "socketmode events_api", ended[0].Name())
+	assert.Equal(t, 2*time.Second, ended[0].EndTime().Sub(ended[0].StartTime()))
+}
@@ -0,0 +1,53 @@
+// Package otelslack exports OpenTelemetry metrics and traces for the slack,
+// RTM and Socket Mode clients.
+//
+//	observer, err := otelslack.NewConnectionObserver()
+//	if err != nil {
+//		return err
+//	}
+//	client := socketmode.New(api, socketmode.OptionObserver(observer))
+package otelslack
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope of the meters and tracers.
+const ScopeName = "github.com/slack-go/slack/otelslack"
+
+type config struct {
+	meterProvider  metric.MeterProvider
+	tracerProvider trace.TracerProvider
+}
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+// WithMeterProvider sets the meter provider. The global one is used by
+// default.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// WithTracerProvider sets the tracer provider. The global one is used by
+// default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+func newConfig(options []Option) config {
+	c := config{
+		meterProvider:  otel.GetMeterProvider(),
+		tracerProvider: otel.GetTracerProvider(),
+	}
+	for _, opt := range options {
+		opt(&c)
+	}
+	return c
+}
@@ -0,0 +1,452 @@
+package slack
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/slack-go/slack/internal/backoff"
+)
+
+const (
+	// DefaultOutboxChannelInterval is the minimum time between two messages
+	// sent by an Outbox to the same channel, as chat.postMessage allows about
+	// one message per second per channel.
+	DefaultOutboxChannelInterval = time.Second
+	// DefaultOutboxMaxAttempts is how many times an Outbox sends a message
+	// that keeps failing, not counting rate limited attempts.
+	DefaultOutboxMaxAttempts = 5
+)
+
+// errOutboxRunning is returned by Run when the outbox is already running.
+var errOutboxRunning = errors.New("slack: outbox is already running")
+
+// OutboxMessage is a message queued in an Outbox. It holds the request the
+// MsgOptions passed to Enqueue resolve to, so that it can be persisted by an
+// OutboxStore, e.g. as JSON.
+type OutboxMessage struct {
+	ID      string
+	Channel string
+	// Method is the API method the message is sent with, e.g.
+	// "chat.postMessage".
+	Method string
+	// Values are the request parameters, without the token.
+	Values url.Values
+	// IdempotencyKey is the key set with MsgOptionIdempotencyKey, if any.
+	IdempotencyKey string `json:",omitempty"`
+	EnqueuedAt     time.Time
+}
+
+// options returns the MsgOption sending the message again.
+func (m OutboxMessage) options() MsgOption {
+	return func(config *sendConfig) error {
+		config.endpoint = config.apiurl + m.Method
+		for key, values := range m.Values {
+			if key == "channel" {
+				continue
+			}
+			config.values[key] = slices.Clone(values)
+		}
+		if m.IdempotencyKey != "" {
+			config.idempotencyKey = m.IdempotencyKey
+			if meta := m.Values.Get("metadata"); meta != "" {
+				if err := json.Unmarshal([]byte(meta), &config.metadata); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// OutboxStore persists the messages queued in an Outbox until they are sent,
+// so that they survive restarts. Implementations must be safe for concurrent
+// use.
+type OutboxStore interface {
+	// Add appends msg to the queue.
+	Add(ctx context.Context, msg OutboxMessage) error
+	// Pending returns the queued messages in the order they were added.
+	Pending(ctx context.Context) ([]OutboxMessage, error)
+	// Remove deletes the message with the given ID once it has been sent or
+	// has failed for good.
+	Remove(ctx context.Context, id string) error
+}
+
+// MemoryOutboxStore is an OutboxStore keeping messages in memory, the
+// default of NewOutbox. Messages are lost when the process exits.
+type MemoryOutboxStore struct {
+	mu       sync.Mutex
+	messages []OutboxMessage
+}
+
+// NewMemoryOutboxStore returns an empty MemoryOutboxStore.
+func NewMemoryOutboxStore() *MemoryOutboxStore {
+	return &MemoryOutboxStore{}
+}
+
+func (s *MemoryOutboxStore) Add(_ context.Context, msg OutboxMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+func (s *MemoryOutboxStore) Pending(context.Context) ([]OutboxMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.messages), nil
+}
+
+func (s *MemoryOutboxStore) Remove(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = slices.DeleteFunc(s.messages, func(msg OutboxMessage) bool { return msg.ID == id })
+	return nil
+}
+
+// OutboxResult reports the outcome of a message sent by an Outbox.
+type OutboxResult struct {
+	Message OutboxMessage
+	// Timestamp is the timestamp of the message, or the ID of the scheduled
+	// message for MsgOptionSchedule.
+	Timestamp string
+	// Attempts is the number of times the message was sent, 0 if it was
+	// found already posted.
+	Attempts int
+	// Err is the error of the last attempt when the message was not sent.
+	Err error
+}
+
+// OutboxOption configures an Outbox.
+type OutboxOption func(*Outbox)
+
+// OutboxOptionStore persists queued messages in store instead of memory.
+func OutboxOptionStore(store OutboxStore) OutboxOption {
+	return func(o *Outbox) {
+		o.store = store
+	}
+}
+
+// OutboxOptionChannelInterval sets the minimum time between two messages sent
+// to the same channel, DefaultOutboxChannelInterval by default.
+func OutboxOptionChannelInterval(d time.Duration) OutboxOption {
+	return func(o *Outbox) {
+		o.channelInterval = d
+	}
+}
+
+// OutboxOptionGlobalInterval sets the minimum time between two messages sent
+// to any channel. Messages are not paced across channels by default.
+func OutboxOptionGlobalInterval(d time.Duration) OutboxOption {
+	return func(o *Outbox) {
+		o.global.interval = d
+	}
+}
+
+// OutboxOptionMaxAttempts sets how many times a message is sent when it fails
+// with a retryable error, DefaultOutboxMaxAttempts by default. Rate limited
+// attempts are not counted.
+func OutboxOptionMaxAttempts(n int) OutboxOption {
+	return func(o *Outbox) {
+		o.maxAttempts = n
+	}
+}
+
+// OutboxOptionBackoff sets the wait between attempts after a retryable error
+// other than a rate limit, which doubles from initial up to max.
+func OutboxOptionBackoff(initial, max time.Duration) OutboxOption {
+	return func(o *Outbox) {
+		o.backoffInitial = initial
+		o.backoffMax = max
+	}
+}
+
+// OutboxOptionOnResult sets a callback invoked once each message has been
+// sent or has failed for good. It is called from the goroutine sending to
+// the channel of the message, which waits for it to return.
+func OutboxOptionOnResult(fn func(OutboxResult)) OutboxOption {
+	return func(o *Outbox) {
+		o.onResult = fn
+	}
+}
+
+// Outbox queues messages and sends them in the background with
+// SendMessageContext, in order for each channel, pacing them per channel and
+// globally. Rate limited messages are sent again once the Retry-After delay
+// of the RateLimitedError has passed; other retryable errors are retried with
+// exponential backoff. Messages failing with errors such as
+// channel_not_found are dropped and reported to the OutboxOptionOnResult
+// callback.
+//
+// Messages are persisted in an OutboxStore until they are sent, so that Run
+// sends the messages left over by a previous process. A message sent just
+// before the process exits may be sent again after a restart. To prevent
+// this, post messages with MsgOptionIdempotencyKey: before sending such a
+// message enqueued before Run was called, the conversation is searched for
+// its key from the time it was enqueued, and it is not sent again if found.
+// Like the lookup of MsgOptionIdempotencyKey, the search covers the 200 most
+// recent messages.
+type Outbox struct {
+	api             *Client
+	store           OutboxStore
+	channelInterval time.Duration
+	maxAttempts     int
+	backoffInitial  time.Duration
+	backoffMax      time.Duration
+	onResult        func(OutboxResult)
+	global          pacer
+
+	mu sync.Mutex
+	// ctx is the context of Run, nil when the outbox is not running.
+	ctx context.Context
+	// started is when Run was called.
+	started time.Time
+	queues  map[string][]OutboxMessage
+	wg      sync.WaitGroup
+}
+
+// NewOutbox returns an Outbox sending messages with api. Call Run to start
+// sending.
+func (api *Client) NewOutbox(options ...OutboxOption) *Outbox {
+	o := &Outbox{
+		api:             api,
+		store:           NewMemoryOutboxStore(),
+		channelInterval: DefaultOutboxChannelInterval,
+		maxAttempts:     DefaultOutboxMaxAttempts,
+	}
+	for _, opt := range options {
+		opt(o)
+	}
+	return o
+}
+
+// Enqueue resolves options into a message to channelID and adds it to the
+// store, returning its ID. It is sent after the messages queued before it
+// for the same channel, once Run is called.
+//
+// Messages are sent with a Web API method; MsgOptionResponseURL and related
+// options are not supported.
+func (o *Outbox) Enqueue(ctx context.Context, channelID string, options ...MsgOption) (string, error) {
+	config, err := applyMsgOptions("", channelID, "", options...)
+	if err != nil {
+		return "", err
+	}
+	if config.mode == chatResponse {
+		return "", errors.New("slack: outbox cannot send messages to a response URL")
+	}
+	// Encode attachments and blocks as formSender does.
+	if config.attachments != nil {
+		attachments, err := json.Marshal(config.attachments)
+		if err != nil {
+			return "", err
+		}
+		config.values.Set("attachments", string(attachments))
+	}
+	if config.blocks.BlockSet != nil {
+		blocks, err := json.Marshal(config.blocks.BlockSet)
+		if err != nil {
+			return "", err
+		}
+		config.values.Set("blocks", string(blocks))
+	}
+	config.values.Del("token")
+
+	msg := OutboxMessage{
+		ID:             rand.Text(),
+		Channel:        channelID,
+		Method:         config.endpoint,
+		Values:         config.values,
+		IdempotencyKey: config.idempotencyKey,
+		EnqueuedAt:     time.Now(),
+	}
+
+	// Hold the lock so that messages are queued in the order they are stored.
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if err := o.store.Add(ctx, msg); err != nil {
+		return "", err
+	}
+	if o.ctx != nil {
+		o.queue(msg)
+	}
+	return msg.ID, nil
+}
+
+// Run sends the messages of the store, then those enqueued, until ctx is done.
+// It returns the context error once the messages being sent are done; those
+// left are sent by the next call to Run.
+func (o *Outbox) Run(ctx context.Context) error {
+	o.mu.Lock()
+	if o.ctx != nil {
+		o.mu.Unlock()
+		return errOutboxRunning
+	}
+	pending, err := o.store.Pending(ctx)
+	if err != nil {
+		o.mu.Unlock()
+		return err
+	}
+	o.ctx = ctx
+	o.started = time.Now()
+	o.queues = make(map[string][]OutboxMessage)
+	for _, msg := range pending {
+		o.queue(msg)
+	}
+	o.mu.Unlock()
+
+	<-ctx.Done()
+	o.wg.Wait()
+
+	o.mu.Lock()
+	o.ctx = nil
+	o.queues = nil
+	o.mu.Unlock()
+	return ctx.Err()
+}
+
+// queue adds msg to the queue of its channel, starting a goroutine sending to
+// the channel if there is none. o.mu must be held.
+func (o *Outbox) queue(msg OutboxMessage) {
+	queue, running := o.queues[msg.Channel]
+	o.queues[msg.Channel] = append(queue, msg)
+	if !running {
+		ctx := o.ctx
+		o.wg.Go(func() { o.drain(ctx, msg.Channel) })
+	}
+}
+
+// drain sends the messages queued for channel until there are none left or
+// ctx is done.
+func (o *Outbox) drain(ctx context.Context, channel string) {
+	for {
+		o.mu.Lock()
+		queue := o.queues[channel]
+		if len(queue) == 0 {
+			delete(o.queues, channel)
+			o.mu.Unlock()
+			return
+		}
+		msg := queue[0]
+		o.mu.Unlock()
+
+		next := time.Now().Add(o.channelInterval)
+		if !o.deliver(ctx, msg) {
+			return
+		}
+
+		o.mu.Lock()
+		o.queues[channel] = o.queues[channel][1:]
+		o.mu.Unlock()
+
+		if !sleepWithContext(ctx, time.Until(next)) {
+			return
+		}
+	}
+}
+
+// deliver sends msg until it succeeds or fails for good, and removes it from
+// the store. It reports false when ctx is done first, leaving msg in the
+// store.
+func (o *Outbox) deliver(ctx context.Context, msg OutboxMessage) bool {
+	bo := &backoff.Backoff{Initial: o.backoffInitial, Max: o.backoffMax}
+	result := OutboxResult{Message: msg}
+	found := o.findPosted(ctx, msg)
+	if ctx.Err() != nil {
+		return false
+	}
+	if found != nil {
+		result.Timestamp = found.Timestamp
+	}
+	failures := 0
+	for found == nil {
+		if !o.global.wait(ctx) {
+			return false
+		}
+		result.Attempts++
+		_, result.Timestamp, _, result.Err = o.api.SendMessageContext(ctx, msg.Channel, msg.options())
+		if result.Err == nil {
+			break
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+
+		var wait time.Duration
+		if rateLimited, ok := errors.AsType[*RateLimitedError](result.Err); ok {
+			wait = max(rateLimited.RetryAfter, o.channelInterval)
+		} else if failures++; failures < o.maxAttempts && retryLater(result.Err) {
+			wait = bo.Duration()
+		} else {
+			break
+		}
+		o.api.logAttrs(ctx, slog.LevelDebug, "slack outbox retry",
+			slog.String("channel", msg.Channel),
+			slog.String("message_id", msg.ID),
+			slog.Int("attempt", result.Attempts),
+			slog.Duration("wait", wait),
+			slog.String("error", result.Err.Error()),
+		)
+		if !sleepWithContext(ctx, wait) {
+			return false
+		}
+	}
+
+	if result.Err != nil {
+		o.api.logAttrs(ctx, slog.LevelWarn, "slack outbox message dropped",
+			slog.String("channel", msg.Channel),
+			slog.String("message_id", msg.ID),
+			slog.Int("attempts", result.Attempts),
+			slog.String("error", result.Err.Error()),
+		)
+	}
+	if err := o.store.Remove(context.WithoutCancel(ctx), msg.ID); err != nil {
+		o.api.Debugf("Failed to remove message %s from the outbox: %v", msg.ID, err)
+	}
+	if o.onResult != nil {
+		o.onResult(result)
+	}
+	return true
+}
+
+// findPosted looks for msg in its conversation when it has an idempotency
+// key and was enqueued before Run was called, as it may have been posted by
+// a previous process. It returns nil if msg is not found.
+func (o *Outbox) findPosted(ctx context.Context, msg OutboxMessage) *chatResponseFull {
+	o.mu.Lock()
+	started := o.started
+	o.mu.Unlock()
+	if msg.IdempotencyKey == "" || msg.Method != string(chatPostMessage) || !msg.EnqueuedAt.Before(started) {
+		return nil
+	}
+	since := msg.EnqueuedAt.Add(-idempotentLookback)
+	return o.api.findIdempotentMessage(ctx, msg.Channel, msg.Values.Get("thread_ts"), msg.IdempotencyKey, since)
+}
+
+// pacer spaces out events by a minimum interval.
+type pacer struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// wait blocks until the next slot, reporting false if ctx is done first.
+func (p *pacer) wait(ctx context.Context) bool {
+	if p.interval <= 0 {
+		return ctx.Err() == nil
+	}
+	p.mu.Lock()
+	at := time.Now()
+	if at.Before(p.next) {
+		at = p.next
+	}
+	p.next = at.Add(p.interval)
+	p.mu.Unlock()
+	return sleepWithContext(ctx, time.Until(at))
+}
@@ -0,0 +1,372 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type outboxPost struct {
+	channel, text string
+	at            time.Time
+}
+
+// outboxServer records chat.postMessage calls, answering with the error set
+// for the text of the message, if any.
+type outboxServer struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	posts  []outboxPost
+	errors map[string][]string
+}
+
+func newOutboxServer(t *testing.T) *outboxServer {
+	s := &outboxServer{errors: map[string][]string{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/chat.postMessage", r.URL.Path)
+		assert.Equal(t, "xoxb-test", r.FormValue("token"))
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		text := r.FormValue("text")
+		s.posts = append(s.posts, outboxPost{channel: r.FormValue("channel"), text: text, at: time.Now()})
+
+		if errs := s.errors[text]; len(errs) > 0 {
+			s.errors[text] = errs[1:]
+			if errs[0] == "ratelimited" {
+				rw.Header().Set("Retry-After", "1")
+				rw.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			rw.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(rw).Encode(map[string]any{"ok": false, "error": errs[0]})
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(rw).Encode(map[string]any{"ok": true, "channel": r.FormValue("channel"), "ts": "1700000000.000001"})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *outboxServer) texts(channel string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var texts []string
+	for _, post := range s.posts {
+		if post.channel == channel {
+			texts = append(texts, post.text)
+		}
+	}
+	return texts
+}
+
+// runOutbox runs o until n results are reported to results, then stops it.
+func runOutbox(t *testing.T, o *Outbox, results <-chan OutboxResult, n int) []OutboxResult {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- o.Run(ctx) }()
+
+	var got []OutboxResult
+	for len(got) < n {
+		select {
+		case result := <-results:
+			got = append(got, result)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("got %d results, want %d", len(got), n)
+		}
+	}
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	return got
+}
+
+func resultsChannel() (chan OutboxResult, OutboxOption) {
+	results := make(chan OutboxResult, 100)
+	return results, OutboxOptionOnResult(func(r OutboxResult) { results <- r })
+}
+
+func TestOutboxPerChannelOrderAndPacing(t *testing.T) {
+	s := newOutboxServer(t)
+	api := New("xoxb-test", OptionAPIURL(s.URL+"/"))
+	results, onResult := resultsChannel()
+	o := api.NewOutbox(onResult, OutboxOptionChannelInterval(50*time.Millisecond))
+
+	ctx := context.Background()
+	for _, msg := range []struct{ channel, text string }{
+		{"C1", "one"}, {"C2", "a"}, {"C1", "two"}, {"C1", "three"}, {"C2", "b"},
+	} {
+		_, err := o.Enqueue(ctx, msg.channel, MsgOptionText(msg.text, false))
+		require.NoError(t, err)
+	}
+
+	for _, result := range runOutbox(t, o, results, 5) {
+		assert.NoError(t, result.Err)
+		assert.Equal(t, "1700000000.000001", result.Timestamp)
+		assert.Equal(t, 1, result.Attempts)
+	}
+	assert.Equal(t, []string{"one", "two", "three"}, s.texts("C1"))
+	assert.Equal(t, []string{"a", "b"}, s.texts("C2"))
+
+	last := map[string]time.Time{}
+	for _, post := range s.posts {
+		if prev, ok := last[post.channel]; ok {
+			assert.GreaterOrEqual(t, post.at.Sub(prev), 45*time.Millisecond)
+		}
+		last[post.channel] = post.at
+	}
+
+	pending, err := o.store.Pending(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestOutboxGlobalPacing(t *testing.T) {
+	s := newOutboxServer(t)
+	api := New("xoxb-test", OptionAPIURL(s.URL+"/"))
+	results, onResult := resultsChannel()
+	o := api.NewOutbox(onResult, OutboxOptionChannelInterval(0), OutboxOptionGlobalInterval(50*time.Millisecond))
+
+	for _, channel := range []string{"C1", "C2", "C3"} {
+		_, err := o.Enqueue(context.Background(), channel, MsgOptionText("hi", false))
+		require.NoError(t, err)
+	}
+	runOutbox(t, o, results, 3)
+
+	require.Len(t, s.posts, 3)
+	for i := 1; i < len(s.posts); i++ {
+		assert.GreaterOrEqual(t, s.posts[i].at.Sub(s.posts[i-1].at), 45*time.Millisecond)
+	}
+}
+
+func TestOutboxRateLimited(t *testing.T) {
+	s := newOutboxServer(t)
+	s.errors["one"] = []string{"ratelimited"}
+	api := New("xoxb-test", OptionAPIURL(s.URL+"/"))
+	results, onResult := resultsChannel()
+	o := api.NewOutbox(onResult, OutboxOptionChannelInterval(0), OutboxOptionMaxAttempts(1))
+
+	for _, text := range []string{"one", "two"} {
+		_, err := o.Enqueue(context.Background(), "C1", MsgOptionText(text, false))
+		require.NoError(t, err)
+	}
+	got := runOutbox(t, o, results, 2)
+
+	assert.NoError(t, got[0].Err)
+	assert.Equal(t, 2, got[0].Attempts, "rate limited attempts do not count toward the maximum")
+	assert.Equal(t, []string{"one", "one", "two"}, s.texts("C1"), "later messages wait for the rate limited one")
+	assert.GreaterOrEqual(t, s.posts[1].at.Sub(s.posts[0].at), time.Second)
+}
+
+func TestOutboxRetries(t *testing.T) {
+	s := newOutboxServer(t)
+	s.errors["retried"] = []string{"internal_error", "service_unavailable"}
+	s.errors["dropped"] = []string{"channel_not_found"}
+	s.errors["exhausted"] = []string{"internal_error", "internal_error", "internal_error"}
+	api := New("xoxb-test", OptionAPIURL(s.URL+"/"))
+	results, onResult := resultsChannel()
+	o := api.NewOutbox(onResult, OutboxOptionChannelInterval(0),
+		OutboxOptionMaxAttempts(3), OutboxOptionBackoff(time.Millisecond, 10*time.Millisecond))
+
+	for _, text := range []string{"retried", "dropped", "ok"} {
+		_, err := o.Enqueue(context.Background(), "C1", MsgOptionText(text, false))
+		require.NoError(t, err)
+	}
+	_, err := o.Enqueue(context.Background(), "C2", MsgOptionText("exhausted", false))
+	require.NoError(t, err)
+
+	byText := map[string]OutboxResult{}
+	for _, result := range runOutbox(t, o, results, 4) {
+		byText[result.Message.Values.Get("text")] = result
+	}
+
+	assert.NoError(t, byText["retried"].Err)
+	assert.Equal(t, 3, byText["retried"].Attempts)
+	assert.ErrorIs(t, byText["dropped"].Err, ErrChannelNotFound)
+	assert.Equal(t, 1, byText["dropped"].Attempts)
+	assert.NoError(t, byText["ok"].Err)
+	assert.ErrorIs(t, byText["exhausted"].Err, ErrInternalError)
+	assert.Equal(t, 3, byText["exhausted"].Attempts)
+	assert.Equal(t, []string{"retried", "retried", "retried", "dropped", "ok"}, s.texts("C1"))
+}
+
+func TestOutboxResumesFromStore(t *testing.T) {
+	api := New("xoxb-test")
+	store := NewMemoryOutboxStore()
+
+	// Messages enqueued by a process that exited before sending them.
+	previous := api.NewOutbox(OutboxOptionStore(store))
+	_, err := previous.Enqueue(context.Background(), "C1",
+		MsgOptionText("one", false),
+		MsgOptionBlocks(NewDividerBlock()),
+		MsgOptionIdempotencyKey("key-1"),
+		MsgOptionMetadata(SlackMetadata{EventType: "order_created", EventPayload: map[string]any{"id": "42"}}),
+	)
+	require.NoError(t, err)
+	_, err = previous.Enqueue(context.Background(), "C1", MsgOptionText("two", false), MsgOptionPostEphemeral("U1"))
+	require.NoError(t, err)
+
+	pending, err := store.Pending(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, "C1", pending[0].Channel)
+	assert.Equal(t, "chat.postMessage", pending[0].Method)
+	assert.Equal(t, "key-1", pending[0].IdempotencyKey)
+	assert.False(t, pending[0].Values.Has("token"))
+	assert.JSONEq(t, `[{"type": "divider"}]`, pending[0].Values.Get("blocks"))
+	assert.Equal(t, "chat.postEphemeral", pending[1].Method)
+
+	// Round trip through JSON, as a persistent store would.
+	data, err := json.Marshal(pending)
+	require.NoError(t, err)
+	var decoded []OutboxMessage
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	restored := NewMemoryOutboxStore()
+	for _, msg := range decoded {
+		require.NoError(t, restored.Add(context.Background(), msg))
+	}
+
+	var (
+		mu       sync.Mutex
+		requests []*http.Request
+		lookups  int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if !assert.NoError(t, r.ParseForm()) {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		mu.Lock()
+		defer mu.Unlock()
+		if r.URL.Path == "/conversations.history" {
+			// The message with an idempotency key was not posted yet.
+			lookups++
+			json.NewEncoder(rw).Encode(map[string]any{"ok": true, "messages": []any{}})
+			return
+		}
+		requests = append(requests, r)
+		json.NewEncoder(rw).Encode(map[string]any{"ok": true, "channel": "C1", "ts": "1700000000.000001"})
+	}))
+	defer server.Close()
+	api = New("xoxb-test", OptionAPIURL(server.URL+"/"))
+	results, onResult := resultsChannel()
+	got := runOutbox(t, api.NewOutbox(OutboxOptionStore(restored), OutboxOptionChannelInterval(0), onResult), results, 2)
+
+	assert.NoError(t, got[0].Err)
+	assert.NoError(t, got[1].Err)
+	assert.Equal(t, 1, lookups, "only the message with an idempotency key is looked up")
+	require.Len(t, requests, 2)
+	assert.Equal(t, "/chat.postMessage", requests[0].URL.Path)
+	assert.Equal(t, "C1", requests[0].PostForm.Get("channel"))
+	assert.Equal(t, "xoxb-test", requests[0].PostForm.Get("token"))
+	assert.JSONEq(t, `{"event_type": "order_created", "event_payload": {"id": "42", "idempotency_key": "key-1"}}`, requests[0].PostForm.Get("metadata"))
+	assert.Equal(t, "/chat.postEphemeral", requests[1].URL.Path)
+	assert.Equal(t, "U1", requests[1].PostForm.Get("user"))
+
+	pending, err = restored.Pending(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestOutboxSkipsMessagesPostedBeforeRestart(t *testing.T) {
+	enqueuedAt := time.Now().Add(-time.Hour)
+	store := NewMemoryOutboxStore()
+	for _, key := range []string{"key-1", "key-2"} {
+		require.NoError(t, store.Add(context.Background(), OutboxMessage{
+			ID:             key,
+			Channel:        "C1",
+			Method:         "chat.postMessage",
+			Values:         url.Values{"text": {key}},
+			IdempotencyKey: key,
+			EnqueuedAt:     enqueuedAt,
+		}))
+	}
+
+	var (
+		mu     sync.Mutex
+		oldest []string
+		posted []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/conversations.history":
+			oldest = append(oldest, r.FormValue("oldest"))
+			// key-1 was posted by the previous process just before it exited.
+			json.NewEncoder(rw).Encode(map[string]any{"ok": true, "messages": []any{map[string]any{
+				"ts":       "1700000000.000001",
+				"text":     "key-1",
+				"metadata": map[string]any{"event_type": IdempotencyEventType, "event_payload": map[string]any{IdempotencyKeyField: "key-1"}},
+			}}})
+		case "/chat.postMessage":
+			posted = append(posted, r.FormValue("text"))
+			json.NewEncoder(rw).Encode(map[string]any{"ok": true, "channel": "C1", "ts": "1700000000.000002"})
+		default:
+			t.Errorf("unexpected call to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	api := New("xoxb-test", OptionAPIURL(server.URL+"/"))
+
+	results, onResult := resultsChannel()
+	got := runOutbox(t, api.NewOutbox(OutboxOptionStore(store), OutboxOptionChannelInterval(0), onResult), results, 2)
+
+	assert.NoError(t, got[0].Err)
+	assert.Equal(t, "1700000000.000001", got[0].Timestamp)
+	assert.Equal(t, 0, got[0].Attempts, "the message found is not sent again")
+	assert.NoError(t, got[1].Err)
+	assert.Equal(t, "1700000000.000002", got[1].Timestamp)
+	assert.Equal(t, 1, got[1].Attempts)
+	assert.Equal(t, []string{"key-2"}, posted)
+
+	since := enqueuedAt.Add(-idempotentLookback)
+	want := fmt.Sprintf("%d.%06d", since.Unix(), since.Nanosecond()/1000)
+	assert.Equal(t, []string{want, want}, oldest, "the lookup starts when the message was enqueued")
+
+	pending, err := store.Pending(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestOutboxEnqueueWhileRunning(t *testing.T) {
+	s := newOutboxServer(t)
+	api := New("xoxb-test", OptionAPIURL(s.URL+"/"))
+	results, onResult := resultsChannel()
+	o := api.NewOutbox(onResult, OutboxOptionChannelInterval(0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- o.Run(ctx) }()
+
+	_, err := o.Enqueue(ctx, "C1", MsgOptionText("one", false))
+	require.NoError(t, err)
+	<-results
+	_, err = o.Enqueue(ctx, "C1", MsgOptionText("two", false))
+	require.NoError(t, err)
+	<-results
+
+	assert.ErrorIs(t, o.Run(ctx), errOutboxRunning)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Equal(t, []string{"one", "two"}, s.texts("C1"))
+}
+
+func TestOutboxRejectsResponseURL(t *testing.T) {
+	o := New("xoxb-test").NewOutbox()
+	_, err := o.Enqueue(context.Background(), "", MsgOptionText("hi", false), MsgOptionResponseURL("https://hooks.slack.com/x", ResponseTypeEphemeral))
+	assert.Error(t, err)
+}
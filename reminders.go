@@ -2,17 +2,163 @@ package slack
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Reminder struct {
-	ID         string `json:"id"`
-	Creator    string `json:"creator"`
-	User       string `json:"user"`
-	Text       string `json:"text"`
-	Recurring  bool   `json:"recurring"`
-	Time       int    `json:"time"`
-	CompleteTS int    `json:"complete_ts"`
+	ID         string              `json:"id"`
+	Creator    string              `json:"creator"`
+	User       string              `json:"user"`
+	Text       string              `json:"text"`
+	Recurring  bool                `json:"recurring"`
+	Recurrence *ReminderRecurrence `json:"recurrence,omitempty"`
+	Time       int                 `json:"time"`
+	CompleteTS int                 `json:"complete_ts"`
+}
+
+// ReminderFrequency is how often a recurring reminder fires.
+type ReminderFrequency string
+
+const (
+	ReminderFrequencyDaily   ReminderFrequency = "daily"
+	ReminderFrequencyWeekly  ReminderFrequency = "weekly"
+	ReminderFrequencyMonthly ReminderFrequency = "monthly"
+	ReminderFrequencyYearly  ReminderFrequency = "yearly"
+)
+
+// ReminderRecurrence describes when a recurring reminder fires. Weekdays is
+// only meaningful for weekly reminders.
+type ReminderRecurrence struct {
+	Frequency ReminderFrequency
+	Weekdays  []time.Weekday
+}
+
+type reminderRecurrenceJSON struct {
+	Frequency ReminderFrequency `json:"frequency"`
+	Weekdays  []string          `json:"weekdays,omitempty"`
+}
+
+func (r ReminderRecurrence) MarshalJSON() ([]byte, error) {
+	out := reminderRecurrenceJSON{Frequency: r.Frequency}
+	for _, day := range r.Weekdays {
+		out.Weekdays = append(out.Weekdays, strings.ToLower(day.String()))
+	}
+	return json.Marshal(out)
+}
+
+func (r *ReminderRecurrence) UnmarshalJSON(data []byte) error {
+	var in reminderRecurrenceJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	r.Frequency = in.Frequency
+	r.Weekdays = nil
+	for _, name := range in.Weekdays {
+		day, ok := parseWeekday(name)
+		if !ok {
+			return fmt.Errorf("unknown weekday %q", name)
+		}
+		r.Weekdays = append(r.Weekdays, day)
+	}
+	return nil
+}
+
+func parseWeekday(name string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(day.String(), name) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// ReminderTimeAt returns a reminders.add time argument for a one-off reminder
+// at t.
+func ReminderTimeAt(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+// ReminderTimeIn returns a reminders.add time argument for a one-off reminder
+// d from now, rounded to whole minutes (at least one).
+func ReminderTimeIn(d time.Duration) string {
+	minutes := max(int64(d.Round(time.Minute)/time.Minute), 1)
+	if minutes == 1 {
+		return "in 1 minute"
+	}
+	return fmt.Sprintf("in %d minutes", minutes)
+}
+
+// ReminderTimeRecurring returns a reminders.add time argument for a reminder
+// repeating according to r, firing at the time of day of at. The date of at
+// selects the day of the month for monthly reminders, the date for yearly
+// reminders, and the weekday for weekly reminders without Weekdays. Slack
+// interprets the time of day in the timezone of the user the reminder is for,
+// so at should be in that location.
+func ReminderTimeRecurring(r ReminderRecurrence, at time.Time) (string, error) {
+	clock := at.Format("3:04pm")
+
+	switch r.Frequency {
+	case ReminderFrequencyDaily:
+		return "every day at " + clock, nil
+	case ReminderFrequencyWeekly:
+		days := r.Weekdays
+		if len(days) == 0 {
+			days = []time.Weekday{at.Weekday()}
+		}
+		for _, day := range days {
+			if day < time.Sunday || day > time.Saturday {
+				return "", fmt.Errorf("invalid weekday %d", day)
+			}
+		}
+		return fmt.Sprintf("every %s at %s", formatWeekdays(days), clock), nil
+	case ReminderFrequencyMonthly:
+		return fmt.Sprintf("every month on the %s at %s", ordinal(at.Day()), clock), nil
+	case ReminderFrequencyYearly:
+		return fmt.Sprintf("every year on %s %s at %s", at.Month(), ordinal(at.Day()), clock), nil
+	default:
+		return "", fmt.Errorf("unknown reminder frequency %q", r.Frequency)
+	}
+}
+
+func formatWeekdays(days []time.Weekday) string {
+	var seen [7]bool
+	for _, day := range days {
+		seen[day] = true
+	}
+	if seen == [7]bool{false, true, true, true, true, true, false} {
+		return "weekday"
+	}
+
+	names := make([]string, 0, len(days))
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if seen[day] {
+			names = append(names, day.String())
+		}
+	}
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return strconv.Itoa(n) + suffix
 }
 
 type reminderResp struct {
@@ -116,3 +262,39 @@ func (api *Client) DeleteReminderContext(ctx context.Context, id string) error {
 	}
 	return response.Err()
 }
+
+// GetReminderInfo gets information about a reminder.
+// For more details, see GetReminderInfoContext documentation.
+func (api *Client) GetReminderInfo(id string) (*Reminder, error) {
+	return api.GetReminderInfoContext(context.Background(), id)
+}
+
+// GetReminderInfoContext gets information about a reminder with a custom context
+// Slack API docs: https://api.slack.com/methods/reminders.info
+func (api *Client) GetReminderInfoContext(ctx context.Context, id string) (*Reminder, error) {
+	values := url.Values{
+		"token":    {api.token},
+		"reminder": {id},
+	}
+	return api.doReminder(ctx, "reminders.info", values)
+}
+
+// CompleteReminder marks a reminder as complete.
+// For more details, see CompleteReminderContext documentation.
+func (api *Client) CompleteReminder(id string) error {
+	return api.CompleteReminderContext(context.Background(), id)
+}
+
+// CompleteReminderContext marks a reminder as complete with a custom context
+// Slack API docs: https://api.slack.com/methods/reminders.complete
+func (api *Client) CompleteReminderContext(ctx context.Context, id string) error {
+	values := url.Values{
+		"token":    {api.token},
+		"reminder": {id},
+	}
+	response := &SlackResponse{}
+	if err := api.postMethod(ctx, "reminders.complete", values, response); err != nil {
+		return err
+	}
+	return response.Err()
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 type remindersHandler struct {
@@ -209,3 +210,96 @@ func TestSlack_ListReminders(t *testing.T) {
 		}
 	}
 }
+
+func TestSlack_GetReminderInfo(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/reminders.info", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.FormValue("reminder"); got != "Rm12345678" {
+			t.Errorf("got reminder %q, want Rm12345678", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"ok": true,
+			"reminder": {
+				"id": "Rm12345678",
+				"creator": "U18888888",
+				"user": "U18888888",
+				"text": "standup",
+				"recurring": true,
+				"recurrence": {"frequency": "weekly", "weekdays": ["monday", "thursday"]},
+				"time": 0,
+				"complete_ts": 0
+			}
+		}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	reminder, err := api.GetReminderInfo("Rm12345678")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	want := &ReminderRecurrence{
+		Frequency: ReminderFrequencyWeekly,
+		Weekdays:  []time.Weekday{time.Monday, time.Thursday},
+	}
+	if !reflect.DeepEqual(reminder.Recurrence, want) {
+		t.Errorf("got recurrence %#v, want %#v", reminder.Recurrence, want)
+	}
+}
+
+func TestSlack_CompleteReminder(t *testing.T) {
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+	rh := newRemindersHandler()
+	http.HandleFunc("/reminders.complete", func(w http.ResponseWriter, r *http.Request) { rh.handler(w, r) })
+
+	if err := api.CompleteReminder("Rm12345678"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if rh.gotParams["reminder"] != "Rm12345678" {
+		t.Errorf("got params %#v", rh.gotParams)
+	}
+	if err := api.CompleteReminder("trigger-error"); err == nil {
+		t.Fatal("Expected error but got none!")
+	}
+}
+
+func TestReminderTime(t *testing.T) {
+	at := time.Date(2024, time.March, 22, 9, 30, 0, 0, time.UTC)
+
+	if got := ReminderTimeAt(at); got != "1711099800" {
+		t.Errorf("ReminderTimeAt: got %q", got)
+	}
+	if got := ReminderTimeIn(90 * time.Minute); got != "in 90 minutes" {
+		t.Errorf("ReminderTimeIn: got %q", got)
+	}
+	if got := ReminderTimeIn(10 * time.Second); got != "in 1 minute" {
+		t.Errorf("ReminderTimeIn: got %q", got)
+	}
+
+	tests := []struct {
+		recurrence ReminderRecurrence
+		want       string
+	}{
+		{ReminderRecurrence{Frequency: ReminderFrequencyDaily}, "every day at 9:30am"},
+		{ReminderRecurrence{Frequency: ReminderFrequencyWeekly}, "every Friday at 9:30am"},
+		{ReminderRecurrence{Frequency: ReminderFrequencyWeekly, Weekdays: []time.Weekday{time.Wednesday, time.Monday}}, "every Monday and Wednesday at 9:30am"},
+		{ReminderRecurrence{Frequency: ReminderFrequencyWeekly, Weekdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}}, "every weekday at 9:30am"},
+		{ReminderRecurrence{Frequency: ReminderFrequencyMonthly}, "every month on the 22nd at 9:30am"},
+		{ReminderRecurrence{Frequency: ReminderFrequencyYearly}, "every year on March 22nd at 9:30am"},
+	}
+	for _, test := range tests {
+		got, err := ReminderTimeRecurring(test.recurrence, at)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if got != test.want {
+			t.Errorf("got %q, want %q", got, test.want)
+		}
+	}
+
+	if _, err := ReminderTimeRecurring(ReminderRecurrence{Frequency: "hourly"}, at); err == nil {
+		t.Error("Expected error for unknown frequency")
+	}
+}
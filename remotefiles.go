@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -128,13 +129,17 @@ func (api *Client) AddRemoteFileContext(ctx context.Context, params RemoteFilePa
 	}
 	switch {
 	case params.PreviewImage != "":
-		err = postLocalWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.remote.add", params.PreviewImage, "preview_image", api.token, values, response, api)
+		err = api.doWithToken(ctx, "files.remote.add", values, response, func(ctx context.Context, token string) (http.Header, error) {
+			return nil, postLocalWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.remote.add", params.PreviewImage, "preview_image", token, valuesWithToken(values, token), response, api)
+		})
 	case params.PreviewImageReader != nil:
 		name := params.PreviewImageName
 		if name == "" {
 			name = "preview.png"
 		}
-		err = postWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.remote.add", name, "preview_image", api.token, values, params.PreviewImageReader, response, api)
+		err = api.doWithToken(ctx, "files.remote.add", values, response, func(ctx context.Context, token string) (http.Header, error) {
+			return nil, postWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.remote.add", name, "preview_image", token, valuesWithToken(values, token), params.PreviewImageReader, response, api)
+		})
 	default:
 		response, err = api.remoteFileRequest(ctx, "files.remote.add", values)
 	}
@@ -278,13 +283,17 @@ func (api *Client) UpdateRemoteFileContext(ctx context.Context, fileID string, p
 	}
 	switch {
 	case params.PreviewImage != "":
-		err = postLocalWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.remote.update", params.PreviewImage, "preview_image", api.token, values, response, api)
+		err = api.doWithToken(ctx, "files.remote.update", values, response, func(ctx context.Context, token string) (http.Header, error) {
+			return nil, postLocalWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.remote.update", params.PreviewImage, "preview_image", token, values, response, api)
+		})
 	case params.PreviewImageReader != nil:
 		name := params.PreviewImageName
 		if name == "" {
 			name = "preview.png"
 		}
-		err = postWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.remote.update", name, "preview_image", api.token, values, params.PreviewImageReader, response, api)
+		err = api.doWithToken(ctx, "files.remote.update", values, response, func(ctx context.Context, token string) (http.Header, error) {
+			return nil, postWithMultipartResponse(ctx, api.httpclient, api.endpoint+"files.remote.update", name, "preview_image", token, values, params.PreviewImageReader, response, api)
+		})
 	default:
 		values.Add("token", api.token)
 		response, err = api.remoteFileRequest(ctx, "files.remote.update", values)
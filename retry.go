@@ -17,8 +17,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"math/rand/v2"
 	"net/http"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -191,7 +193,17 @@ func (c *retryClient) handlers() []RetryHandler {
 	return DefaultRetryHandlers(c.config)
 }
 
-func (c *retryClient) logRetry(attempt int, reason string, detail any) {
+func (c *retryClient) logRetry(req *http.Request, attempt int, reason string, detail any) {
+	if api, ok := c.debug.(*Client); ok && api.slog != nil {
+		api.logAttrs(req.Context(), slog.LevelWarn, "slack retry",
+			slog.String("method", path.Base(req.URL.Path)),
+			slog.Int("retry_attempt", attempt+1),
+			slog.Int("max_attempts", c.config.MaxRetries+1),
+			slog.String("reason", reason),
+			slog.Any("detail", detail),
+		)
+		return
+	}
 	if c.debug == nil || !c.debug.Debug() {
 		return
 	}
@@ -254,7 +266,10 @@ func (c *retryClient) Do(req *http.Request) (*http.Response, error) {
 		}
 
 		// No retries left or request body cannot be replayed — return now.
-		if attempt >= c.config.MaxRetries || !requestRetryable(req) {
+		// Messages with an idempotency key are only retried once it is known
+		// that they were not posted, see sendIdempotent.
+		ambiguous := err != nil || resp.StatusCode >= http.StatusInternalServerError
+		if attempt >= c.config.MaxRetries || !requestRetryable(req) || (ambiguous && isIdempotentSend(req.Context())) {
 			if err != nil {
 				return nil, err
 			}
@@ -279,16 +294,17 @@ func (c *retryClient) Do(req *http.Request) (*http.Response, error) {
 
 		// Log, discard response body if we have one, sleep, then next attempt.
 		if err != nil {
-			c.logRetry(attempt, "connection error", err)
+			c.logRetry(req, attempt, "connection error", err)
 		} else {
 			reason := fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
-			c.logRetry(attempt, reason, wait)
+			c.logRetry(req, attempt, reason, wait)
 			_, _ = io.Copy(io.Discard, resp.Body)
 			_ = resp.Body.Close()
 		}
 		if !sleepWithContext(req.Context(), wait) {
 			return nil, req.Context().Err()
 		}
+		countRetry(req.Context())
 	}
 
 	return nil, lastErr
@@ -112,6 +112,14 @@ func RTMOptionConnParams(connParams url.Values) RTMOption {
 	}
 }
 
+// RTMOptionObserver reports connection attempts, reconnections, ping
+// latency, incoming events and message acknowledgements to o.
+func RTMOptionObserver(o ConnectionObserver) RTMOption {
+	return func(rtm *RTM) {
+		rtm.observer = o
+	}
+}
+
 // NewRTM returns a RTM, which provides a fully managed connection to
 // Slack's websocket-based Real-Time Messaging protocol.
 func (api *Client) NewRTM(options ...RTMOption) *RTM {
@@ -127,6 +135,7 @@ func (api *Client) NewRTM(options ...RTMOption) *RTM {
 		forcePing:        make(chan bool),
 		idGen:            NewSafeID(1),
 		mu:               &sync.Mutex{},
+		observer:         NopConnectionObserver{},
 	}
 
 	for _, opt := range options {
@@ -0,0 +1,651 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SCIMVersion is a version of Slack's SCIM API.
+type SCIMVersion string
+
+const (
+	SCIMVersion1 SCIMVersion = "v1"
+	SCIMVersion2 SCIMVersion = "v2"
+)
+
+// SCIM schema URNs used by Slack.
+const (
+	SCIMSchemaUserV1           = "urn:scim:schemas:core:1.0"
+	SCIMSchemaEnterpriseUserV1 = "urn:scim:schemas:extension:enterprise:1.0"
+	SCIMSchemaUserV2           = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMSchemaGroupV2          = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCIMSchemaEnterpriseUserV2 = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	SCIMSchemaPatchOpV2        = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SCIMSchemaErrorV2          = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// SCIMName holds the components of a user's name.
+type SCIMName struct {
+	GivenName       string `json:"givenName,omitempty"`
+	FamilyName      string `json:"familyName,omitempty"`
+	HonorificPrefix string `json:"honorificPrefix,omitempty"`
+	Formatted       string `json:"formatted,omitempty"`
+}
+
+// SCIMMultiValue is a multi-valued attribute such as an email address, phone
+// number, photo or role.
+type SCIMMultiValue struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Display string `json:"display,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// SCIMAddress is a user's physical mailing address.
+type SCIMAddress struct {
+	StreetAddress string `json:"streetAddress,omitempty"`
+	Locality      string `json:"locality,omitempty"`
+	Region        string `json:"region,omitempty"`
+	PostalCode    string `json:"postalCode,omitempty"`
+	Country       string `json:"country,omitempty"`
+	Primary       bool   `json:"primary,omitempty"`
+}
+
+// SCIMManager references a user's manager. SCIM v1 identifies the manager
+// with ManagerID, SCIM v2 with Value.
+type SCIMManager struct {
+	ManagerID string `json:"managerId,omitempty"`
+	Value     string `json:"value,omitempty"`
+}
+
+// SCIMEnterpriseUser holds the attributes of the enterprise user schema
+// extension.
+type SCIMEnterpriseUser struct {
+	EmployeeNumber string       `json:"employeeNumber,omitempty"`
+	CostCenter     string       `json:"costCenter,omitempty"`
+	Organization   string       `json:"organization,omitempty"`
+	Division       string       `json:"division,omitempty"`
+	Department     string       `json:"department,omitempty"`
+	Manager        *SCIMManager `json:"manager,omitempty"`
+}
+
+// SCIMMeta contains resource metadata set by Slack.
+type SCIMMeta struct {
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+	ResourceType string `json:"resourceType,omitempty"`
+}
+
+// SCIMGroupRef references a group a user belongs to.
+type SCIMGroupRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// SCIMUser is a SCIM user resource. Enterprise holds the enterprise schema
+// extension, which is encoded under the URN matching the API version found
+// in Schemas.
+type SCIMUser struct {
+	Schemas           []string            `json:"schemas,omitempty"`
+	ID                string              `json:"id,omitempty"`
+	ExternalID        string              `json:"externalId,omitempty"`
+	UserName          string              `json:"userName,omitempty"`
+	NickName          string              `json:"nickName,omitempty"`
+	Name              *SCIMName           `json:"name,omitempty"`
+	DisplayName       string              `json:"displayName,omitempty"`
+	ProfileURL        string              `json:"profileUrl,omitempty"`
+	Title             string              `json:"title,omitempty"`
+	Timezone          string              `json:"timezone,omitempty"`
+	UserType          string              `json:"userType,omitempty"`
+	PreferredLanguage string              `json:"preferredLanguage,omitempty"`
+	Active            *bool               `json:"active,omitempty"`
+	Password          string              `json:"password,omitempty"`
+	Emails            []SCIMMultiValue    `json:"emails,omitempty"`
+	PhoneNumbers      []SCIMMultiValue    `json:"phoneNumbers,omitempty"`
+	Photos            []SCIMMultiValue    `json:"photos,omitempty"`
+	Roles             []SCIMMultiValue    `json:"roles,omitempty"`
+	Addresses         []SCIMAddress       `json:"addresses,omitempty"`
+	Groups            []SCIMGroupRef      `json:"groups,omitempty"`
+	Meta              *SCIMMeta           `json:"meta,omitempty"`
+	Enterprise        *SCIMEnterpriseUser `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler, writing the enterprise extension
+// under the URN of the version declared in Schemas.
+func (u SCIMUser) MarshalJSON() ([]byte, error) {
+	type alias SCIMUser
+	b, err := json.Marshal(alias(u))
+	if err != nil || u.Enterprise == nil {
+		return b, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	ext, err := json.Marshal(u.Enterprise)
+	if err != nil {
+		return nil, err
+	}
+	fields[scimEnterpriseSchema(u.Schemas)] = ext
+
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reading the enterprise
+// extension from either the v1 or the v2 URN.
+func (u *SCIMUser) UnmarshalJSON(data []byte) error {
+	type alias SCIMUser
+	if err := json.Unmarshal(data, (*alias)(u)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for _, key := range []string{SCIMSchemaEnterpriseUserV2, SCIMSchemaEnterpriseUserV1} {
+		if raw, ok := fields[key]; ok {
+			u.Enterprise = &SCIMEnterpriseUser{}
+			return json.Unmarshal(raw, u.Enterprise)
+		}
+	}
+
+	return nil
+}
+
+// scimEnterpriseSchema returns the enterprise extension URN matching the
+// version of the given schemas, defaulting to v2.
+func scimEnterpriseSchema(schemas []string) string {
+	for _, s := range schemas {
+		if s == SCIMSchemaUserV1 || s == SCIMSchemaEnterpriseUserV1 {
+			return SCIMSchemaEnterpriseUserV1
+		}
+	}
+	return SCIMSchemaEnterpriseUserV2
+}
+
+// SCIMMember is a member of a SCIM group.
+type SCIMMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// SCIMGroup is a SCIM group resource.
+type SCIMGroup struct {
+	Schemas     []string     `json:"schemas,omitempty"`
+	ID          string       `json:"id,omitempty"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Members     []SCIMMember `json:"members,omitempty"`
+	Meta        *SCIMMeta    `json:"meta,omitempty"`
+}
+
+// SCIMListParams contains the filtering and pagination arguments of the
+// SCIM list methods. StartIndex is 1-based.
+type SCIMListParams struct {
+	Filter     string
+	StartIndex int
+	Count      int
+}
+
+func (p SCIMListParams) values() url.Values {
+	values := url.Values{}
+	if p.Filter != "" {
+		values.Add("filter", p.Filter)
+	}
+	if p.StartIndex > 0 {
+		values.Add("startIndex", strconv.Itoa(p.StartIndex))
+	}
+	if p.Count > 0 {
+		values.Add("count", strconv.Itoa(p.Count))
+	}
+	return values
+}
+
+// SCIMListResponse holds the pagination details of a SCIM list response.
+type SCIMListResponse struct {
+	Schemas      []string `json:"schemas,omitempty"`
+	TotalResults int      `json:"totalResults"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	StartIndex   int      `json:"startIndex"`
+}
+
+// NextStartIndex returns the StartIndex of the next page, or 0 when this is
+// the last page.
+func (r SCIMListResponse) NextStartIndex() int {
+	next := r.StartIndex + r.ItemsPerPage
+	if r.ItemsPerPage <= 0 || next > r.TotalResults {
+		return 0
+	}
+	return next
+}
+
+// SCIMUserList is a page of SCIM users.
+type SCIMUserList struct {
+	SCIMListResponse
+	Resources []SCIMUser `json:"Resources"`
+}
+
+// SCIMGroupList is a page of SCIM groups.
+type SCIMGroupList struct {
+	SCIMListResponse
+	Resources []SCIMGroup `json:"Resources"`
+}
+
+// SCIMPatchOperation is a single PATCH operation. In SCIM v2 operations are
+// sent as a PatchOp message; in SCIM v1 they are translated into the partial
+// resource Slack expects.
+type SCIMPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// SCIMPatchAdd builds an "add" operation.
+func SCIMPatchAdd(path string, value any) SCIMPatchOperation {
+	return SCIMPatchOperation{Op: "add", Path: path, Value: value}
+}
+
+// SCIMPatchReplace builds a "replace" operation.
+func SCIMPatchReplace(path string, value any) SCIMPatchOperation {
+	return SCIMPatchOperation{Op: "replace", Path: path, Value: value}
+}
+
+// SCIMPatchRemove builds a "remove" operation. In SCIM v1 only the removal
+// of group members is supported, with value holding the []SCIMMember to
+// remove.
+func SCIMPatchRemove(path string, value any) SCIMPatchOperation {
+	return SCIMPatchOperation{Op: "remove", Path: path, Value: value}
+}
+
+// SCIMError is an error returned by the SCIM API.
+type SCIMError struct {
+	Status   int
+	Detail   string
+	ScimType string
+}
+
+func (e SCIMError) Error() string {
+	return fmt.Sprintf("slack scim error (status %d): %s", e.Status, e.Detail)
+}
+
+// HTTPStatusCode returns the HTTP status code of the response.
+func (e SCIMError) HTTPStatusCode() int {
+	return e.Status
+}
+
+// parseSCIMError reads a SCIM error body in either the v1 or the v2 format.
+func parseSCIMError(resp *http.Response) error {
+	scimErr := SCIMError{Status: resp.StatusCode, Detail: resp.Status}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || len(body) == 0 {
+		return scimErr
+	}
+
+	var payload struct {
+		Detail   string          `json:"detail"`
+		ScimType string          `json:"scimType"`
+		Errors   json.RawMessage `json:"Errors"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return scimErr
+	}
+
+	if payload.Detail != "" {
+		scimErr.Detail = payload.Detail
+		scimErr.ScimType = payload.ScimType
+		return scimErr
+	}
+
+	// SCIM v1 returns either a single error object or a list of them.
+	type v1Error struct {
+		Description string `json:"description"`
+	}
+	var one v1Error
+	if err := json.Unmarshal(payload.Errors, &one); err == nil && one.Description != "" {
+		scimErr.Detail = one.Description
+		return scimErr
+	}
+	var many []v1Error
+	if err := json.Unmarshal(payload.Errors, &many); err == nil && len(many) > 0 {
+		scimErr.Detail = many[0].Description
+	}
+
+	return scimErr
+}
+
+func (api *Client) scimRequest(ctx context.Context, method, path string, values url.Values, body any, intf any) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	// The SCIM API uses a different base URL (api.slack.com/scim instead of slack.com/api)
+	endpoint := api.scimEndpoint + string(api.scimVersion) + "/" + path
+	// Calls are reported per resource type, without the ID of the resource.
+	resource, _, _ := strings.Cut(path, "/")
+	return api.doWithToken(ctx, "scim/"+string(api.scimVersion)+"/"+resource, values, intf, func(ctx context.Context, token string) (http.Header, error) {
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Accept", "application/json")
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json; charset=utf-8")
+			// allow retry client to re-send the request body on 429/5xx.
+			req.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(payload)), nil
+			}
+		}
+		req.URL.RawQuery = values.Encode()
+
+		resp, err := api.httpclient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			if err := checkStatusCode(resp, api); err != nil {
+				return resp.Header, err
+			}
+		}
+
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+			return resp.Header, parseSCIMError(resp)
+		}
+
+		if intf == nil || resp.StatusCode == http.StatusNoContent {
+			return resp.Header, nil
+		}
+
+		return resp.Header, json.NewDecoder(resp.Body).Decode(intf)
+	})
+}
+
+// scimUserSchemas returns the schemas to send for a user when the caller
+// did not set them.
+func (api *Client) scimUserSchemas(user SCIMUser) []string {
+	if len(user.Schemas) > 0 {
+		return user.Schemas
+	}
+	if api.scimVersion == SCIMVersion1 {
+		schemas := []string{SCIMSchemaUserV1}
+		if user.Enterprise != nil {
+			schemas = append(schemas, SCIMSchemaEnterpriseUserV1)
+		}
+		return schemas
+	}
+	schemas := []string{SCIMSchemaUserV2}
+	if user.Enterprise != nil {
+		schemas = append(schemas, SCIMSchemaEnterpriseUserV2)
+	}
+	return schemas
+}
+
+func (api *Client) scimGroupSchemas(group SCIMGroup) []string {
+	if len(group.Schemas) > 0 {
+		return group.Schemas
+	}
+	if api.scimVersion == SCIMVersion1 {
+		return []string{SCIMSchemaUserV1}
+	}
+	return []string{SCIMSchemaGroupV2}
+}
+
+// scimPatchBody builds the PATCH payload for the client's SCIM version.
+func (api *Client) scimPatchBody(schemas []string, ops []SCIMPatchOperation) (any, error) {
+	if api.scimVersion != SCIMVersion1 {
+		return struct {
+			Schemas    []string             `json:"schemas"`
+			Operations []SCIMPatchOperation `json:"Operations"`
+		}{
+			Schemas:    []string{SCIMSchemaPatchOpV2},
+			Operations: ops,
+		}, nil
+	}
+
+	body := map[string]any{"schemas": schemas}
+	for _, op := range ops {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if op.Path == "" {
+				fields, err := scimToMap(op.Value)
+				if err != nil {
+					return nil, err
+				}
+				for k, v := range fields {
+					body[k] = v
+				}
+				continue
+			}
+			if op.Path == "members" {
+				members, err := scimMembers(op.Value, "")
+				if err != nil {
+					return nil, err
+				}
+				body["members"] = append(scimMemberList(body), members...)
+				continue
+			}
+			scimSetPath(body, op.Path, op.Value)
+		case "remove":
+			if op.Path != "members" {
+				return nil, fmt.Errorf("scim v1 does not support removing %q", op.Path)
+			}
+			members, err := scimMembers(op.Value, "delete")
+			if err != nil {
+				return nil, err
+			}
+			body["members"] = append(scimMemberList(body), members...)
+		default:
+			return nil, fmt.Errorf("unknown scim patch operation %q", op.Op)
+		}
+	}
+
+	return body, nil
+}
+
+func scimToMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, fmt.Errorf("scim patch value without a path must be an object: %w", err)
+	}
+	return fields, nil
+}
+
+func scimMemberList(body map[string]any) []any {
+	members, _ := body["members"].([]any)
+	return members
+}
+
+// scimMembers converts a member patch value into SCIM v1 member entries,
+// tagging each one with operation when set.
+func scimMembers(v any, operation string) ([]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var members []SCIMMember
+	if err := json.Unmarshal(b, &members); err != nil {
+		return nil, fmt.Errorf("scim members value must be a list of members: %w", err)
+	}
+
+	result := make([]any, 0, len(members))
+	for _, m := range members {
+		entry := map[string]any{"value": m.Value}
+		if operation != "" {
+			entry["operation"] = operation
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// scimSetPath sets a dotted attribute path such as "name.givenName" in body.
+// A leading extension URN is kept as a single path segment.
+func scimSetPath(body map[string]any, path string, value any) {
+	var segments []string
+	for _, urn := range []string{SCIMSchemaEnterpriseUserV1, SCIMSchemaEnterpriseUserV2} {
+		if rest, ok := strings.CutPrefix(path, urn); ok {
+			segments = append(segments, urn)
+			path = strings.TrimLeft(rest, ".:")
+			break
+		}
+	}
+	if path != "" {
+		segments = append(segments, strings.Split(path, ".")...)
+	}
+
+	current := body
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			current[segment] = next
+		}
+		current = next
+	}
+	current[segments[len(segments)-1]] = value
+}
+
+// ListSCIMUsers returns a page of users, optionally filtered with a SCIM
+// filter expression such as `email eq "user@example.com"`.
+// Slack API docs: https://docs.slack.dev/admins/scim-api
+func (api *Client) ListSCIMUsers(ctx context.Context, params SCIMListParams) (*SCIMUserList, error) {
+	response := &SCIMUserList{}
+	if err := api.scimRequest(ctx, http.MethodGet, "Users", params.values(), nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetSCIMUser returns a single user.
+func (api *Client) GetSCIMUser(ctx context.Context, userID string) (*SCIMUser, error) {
+	response := &SCIMUser{}
+	if err := api.scimRequest(ctx, http.MethodGet, "Users/"+url.PathEscape(userID), nil, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// CreateSCIMUser provisions a new user. Schemas are filled in for the
+// client's SCIM version when left empty.
+func (api *Client) CreateSCIMUser(ctx context.Context, user SCIMUser) (*SCIMUser, error) {
+	user.Schemas = api.scimUserSchemas(user)
+
+	response := &SCIMUser{}
+	if err := api.scimRequest(ctx, http.MethodPost, "Users", nil, user, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// PatchSCIMUser updates some attributes of a user.
+func (api *Client) PatchSCIMUser(ctx context.Context, userID string, ops ...SCIMPatchOperation) (*SCIMUser, error) {
+	body, err := api.scimPatchBody(api.scimUserSchemas(SCIMUser{}), ops)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SCIMUser{}
+	if err := api.scimRequest(ctx, http.MethodPatch, "Users/"+url.PathEscape(userID), nil, body, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ReplaceSCIMUser replaces all attributes of a user. Attributes left empty
+// are cleared.
+func (api *Client) ReplaceSCIMUser(ctx context.Context, userID string, user SCIMUser) (*SCIMUser, error) {
+	user.Schemas = api.scimUserSchemas(user)
+
+	response := &SCIMUser{}
+	if err := api.scimRequest(ctx, http.MethodPut, "Users/"+url.PathEscape(userID), nil, user, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DeactivateSCIMUser deactivates a user. Slack does not delete users; they
+// can be reactivated by patching active to true.
+func (api *Client) DeactivateSCIMUser(ctx context.Context, userID string) error {
+	return api.scimRequest(ctx, http.MethodDelete, "Users/"+url.PathEscape(userID), nil, nil, nil)
+}
+
+// ListSCIMGroups returns a page of groups, optionally filtered with a SCIM
+// filter expression such as `displayName eq "Engineering"`.
+func (api *Client) ListSCIMGroups(ctx context.Context, params SCIMListParams) (*SCIMGroupList, error) {
+	response := &SCIMGroupList{}
+	if err := api.scimRequest(ctx, http.MethodGet, "Groups", params.values(), nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetSCIMGroup returns a single group.
+func (api *Client) GetSCIMGroup(ctx context.Context, groupID string) (*SCIMGroup, error) {
+	response := &SCIMGroup{}
+	if err := api.scimRequest(ctx, http.MethodGet, "Groups/"+url.PathEscape(groupID), nil, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// CreateSCIMGroup creates a new group.
+func (api *Client) CreateSCIMGroup(ctx context.Context, group SCIMGroup) (*SCIMGroup, error) {
+	group.Schemas = api.scimGroupSchemas(group)
+
+	response := &SCIMGroup{}
+	if err := api.scimRequest(ctx, http.MethodPost, "Groups", nil, group, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// PatchSCIMGroup updates the name or members of a group, e.g.
+//
+//	api.PatchSCIMGroup(ctx, groupID,
+//		slack.SCIMPatchAdd("members", []slack.SCIMMember{{Value: "U123"}}),
+//		slack.SCIMPatchRemove("members", []slack.SCIMMember{{Value: "U456"}}),
+//	)
+func (api *Client) PatchSCIMGroup(ctx context.Context, groupID string, ops ...SCIMPatchOperation) error {
+	body, err := api.scimPatchBody(api.scimGroupSchemas(SCIMGroup{}), ops)
+	if err != nil {
+		return err
+	}
+
+	return api.scimRequest(ctx, http.MethodPatch, "Groups/"+url.PathEscape(groupID), nil, body, nil)
+}
+
+// ReplaceSCIMGroup replaces the name and members of a group.
+func (api *Client) ReplaceSCIMGroup(ctx context.Context, groupID string, group SCIMGroup) (*SCIMGroup, error) {
+	group.Schemas = api.scimGroupSchemas(group)
+
+	response := &SCIMGroup{}
+	if err := api.scimRequest(ctx, http.MethodPut, "Groups/"+url.PathEscape(groupID), nil, group, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DeleteSCIMGroup deletes a group.
+func (api *Client) DeleteSCIMGroup(ctx context.Context, groupID string) error {
+	return api.scimRequest(ctx, http.MethodDelete, "Groups/"+url.PathEscape(groupID), nil, nil, nil)
+}
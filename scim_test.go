@@ -0,0 +1,252 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSCIMTestClient(version SCIMVersion) *Client {
+	once.Do(startServer)
+	return New("testing-token",
+		OptionSCIMAPIURL("http://"+serverAddr+"/scim/"),
+		OptionSCIMVersion(version),
+	)
+}
+
+func TestListSCIMUsers(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/scim/v2/Users", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "Bearer testing-token", r.Header.Get("Authorization"))
+		assert.Equal(t, `email eq "bird@example.com"`, r.URL.Query().Get("filter"))
+		assert.Equal(t, "3", r.URL.Query().Get("startIndex"))
+		assert.Equal(t, "2", r.URL.Query().Get("count"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:ListResponse"],
+			"totalResults": 5,
+			"itemsPerPage": 2,
+			"startIndex": 3,
+			"Resources": [{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User", "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"],
+				"id": "W1234",
+				"userName": "bird",
+				"active": true,
+				"emails": [{"value": "bird@example.com", "primary": true}],
+				"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": {
+					"department": "Jazz",
+					"manager": {"value": "W5678"}
+				}
+			}]
+		}`))
+	})
+	api := newSCIMTestClient(SCIMVersion2)
+
+	list, err := api.ListSCIMUsers(context.Background(), SCIMListParams{
+		Filter:     `email eq "bird@example.com"`,
+		StartIndex: 3,
+		Count:      2,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, list.TotalResults)
+	assert.Equal(t, 5, list.NextStartIndex())
+	require.Len(t, list.Resources, 1)
+	user := list.Resources[0]
+	assert.Equal(t, "W1234", user.ID)
+	require.NotNil(t, user.Active)
+	assert.True(t, *user.Active)
+	require.NotNil(t, user.Enterprise)
+	assert.Equal(t, "Jazz", user.Enterprise.Department)
+	assert.Equal(t, "W5678", user.Enterprise.Manager.Value)
+}
+
+func TestSCIMListResponseNextStartIndex(t *testing.T) {
+	assert.Equal(t, 0, SCIMListResponse{TotalResults: 4, ItemsPerPage: 2, StartIndex: 3}.NextStartIndex())
+	assert.Equal(t, 3, SCIMListResponse{TotalResults: 4, ItemsPerPage: 2, StartIndex: 1}.NextStartIndex())
+	assert.Equal(t, 0, SCIMListResponse{}.NextStartIndex())
+}
+
+func TestCreateSCIMUserV1(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/scim/v1/Users", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []any{SCIMSchemaUserV1, SCIMSchemaEnterpriseUserV1}, body["schemas"])
+		assert.Equal(t, map[string]any{"department": "Jazz"}, body[SCIMSchemaEnterpriseUserV1])
+		assert.Equal(t, "bird", body["userName"])
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(`{
+			"schemas": ["urn:scim:schemas:core:1.0", "urn:scim:schemas:extension:enterprise:1.0"],
+			"id": "W1234",
+			"userName": "bird",
+			"urn:scim:schemas:extension:enterprise:1.0": {"department": "Jazz", "manager": {"managerId": "W5678"}}
+		}`))
+	})
+	api := newSCIMTestClient(SCIMVersion1)
+
+	user, err := api.CreateSCIMUser(context.Background(), SCIMUser{
+		UserName:   "bird",
+		Emails:     []SCIMMultiValue{{Value: "bird@example.com", Primary: true}},
+		Enterprise: &SCIMEnterpriseUser{Department: "Jazz"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "W1234", user.ID)
+	require.NotNil(t, user.Enterprise)
+	assert.Equal(t, "W5678", user.Enterprise.Manager.ManagerID)
+}
+
+func TestPatchSCIMUserV2(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/scim/v2/Users/W1234", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		b, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations": [{"op": "replace", "path": "active", "value": false}]
+		}`, string(b))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": "W1234", "active": false}`))
+	})
+	api := newSCIMTestClient(SCIMVersion2)
+
+	user, err := api.PatchSCIMUser(context.Background(), "W1234", SCIMPatchReplace("active", false))
+	require.NoError(t, err)
+	require.NotNil(t, user.Active)
+	assert.False(t, *user.Active)
+}
+
+func TestPatchSCIMUserV1(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/scim/v1/Users/W1234", func(rw http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"schemas": ["urn:scim:schemas:core:1.0"],
+			"title": "Lead",
+			"name": {"givenName": "Charlie"},
+			"urn:scim:schemas:extension:enterprise:1.0": {"department": "Bebop"}
+		}`, string(b))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": "W1234"}`))
+	})
+	api := newSCIMTestClient(SCIMVersion1)
+
+	_, err := api.PatchSCIMUser(context.Background(), "W1234",
+		SCIMPatchReplace("", map[string]string{"title": "Lead"}),
+		SCIMPatchReplace("name.givenName", "Charlie"),
+		SCIMPatchReplace(SCIMSchemaEnterpriseUserV1+".department", "Bebop"),
+	)
+	require.NoError(t, err)
+}
+
+func TestPatchSCIMGroupV1Members(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/scim/v1/Groups/S1234", func(rw http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"schemas": ["urn:scim:schemas:core:1.0"],
+			"members": [{"value": "U1"}, {"value": "U2", "operation": "delete"}]
+		}`, string(b))
+		rw.WriteHeader(http.StatusNoContent)
+	})
+	api := newSCIMTestClient(SCIMVersion1)
+
+	err := api.PatchSCIMGroup(context.Background(), "S1234",
+		SCIMPatchAdd("members", []SCIMMember{{Value: "U1"}}),
+		SCIMPatchRemove("members", []SCIMMember{{Value: "U2"}}),
+	)
+	require.NoError(t, err)
+
+	err = api.PatchSCIMGroup(context.Background(), "S1234", SCIMPatchRemove("displayName", nil))
+	require.Error(t, err)
+}
+
+func TestDeactivateSCIMUser(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/scim/v2/Users/W1234", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		rw.WriteHeader(http.StatusNoContent)
+	})
+	api := newSCIMTestClient(SCIMVersion2)
+
+	require.NoError(t, api.DeactivateSCIMUser(context.Background(), "W1234"))
+}
+
+func TestCreateSCIMGroup(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/scim/v2/Groups", func(rw http.ResponseWriter, r *http.Request) {
+		var body SCIMGroup
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{SCIMSchemaGroupV2}, body.Schemas)
+		body.ID = "S1234"
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusCreated)
+		json.NewEncoder(rw).Encode(body)
+	})
+	api := newSCIMTestClient(SCIMVersion2)
+
+	group, err := api.CreateSCIMGroup(context.Background(), SCIMGroup{
+		DisplayName: "Engineering",
+		Members:     []SCIMMember{{Value: "W1234"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "S1234", group.ID)
+	assert.Equal(t, "Engineering", group.DisplayName)
+}
+
+func TestSCIMErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		version SCIMVersion
+		status  int
+		body    string
+		want    SCIMError
+	}{
+		{
+			name:    "v1",
+			version: SCIMVersion1,
+			status:  http.StatusNotFound,
+			body:    `{"Errors": {"description": "user_not_found", "code": 404}}`,
+			want:    SCIMError{Status: http.StatusNotFound, Detail: "user_not_found"},
+		},
+		{
+			name:    "v2",
+			version: SCIMVersion2,
+			status:  http.StatusConflict,
+			body:    `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:Error"], "detail": "username_taken", "scimType": "uniqueness", "status": "409"}`,
+			want:    SCIMError{Status: http.StatusConflict, Detail: "username_taken", ScimType: "uniqueness"},
+		},
+		{
+			name:    "no body",
+			version: SCIMVersion2,
+			status:  http.StatusForbidden,
+			want:    SCIMError{Status: http.StatusForbidden, Detail: "403 Forbidden"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			http.DefaultServeMux = new(http.ServeMux)
+			http.HandleFunc("/scim/"+string(tt.version)+"/Users/W1", func(rw http.ResponseWriter, r *http.Request) {
+				rw.WriteHeader(tt.status)
+				rw.Write([]byte(tt.body))
+			})
+			api := newSCIMTestClient(tt.version)
+
+			_, err := api.GetSCIMUser(context.Background(), "W1")
+			var scimErr SCIMError
+			require.True(t, errors.As(err, &scimErr))
+			assert.Equal(t, tt.want, scimErr)
+		})
+	}
+}
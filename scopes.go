@@ -0,0 +1,209 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MethodScopes maps Web API methods to the OAuth scopes that allow calling
+// them. Any one of the listed scopes is sufficient, e.g. conversations.history
+// works with channels:history for public channels and groups:history for
+// private ones. Methods that need no scope map to an empty list.
+//
+// The table covers commonly used methods and is consulted by CheckScopes;
+// apps can add or override entries at startup.
+var MethodScopes = map[string][]string{
+	"auth.test":                    {},
+	"bookmarks.add":                {"bookmarks:write"},
+	"bookmarks.edit":               {"bookmarks:write"},
+	"bookmarks.list":               {"bookmarks:read"},
+	"bookmarks.remove":             {"bookmarks:write"},
+	"bots.info":                    {"users:read"},
+	"chat.delete":                  {"chat:write"},
+	"chat.deleteScheduledMessage":  {"chat:write"},
+	"chat.getPermalink":            {},
+	"chat.meMessage":               {"chat:write"},
+	"chat.postEphemeral":           {"chat:write"},
+	"chat.postMessage":             {"chat:write"},
+	"chat.scheduleMessage":         {"chat:write"},
+	"chat.scheduledMessages.list":  {"chat:write"},
+	"chat.unfurl":                  {"links:write"},
+	"chat.update":                  {"chat:write"},
+	"conversations.archive":        {"channels:manage", "groups:write", "im:write", "mpim:write"},
+	"conversations.close":          {"channels:manage", "groups:write", "im:write", "mpim:write"},
+	"conversations.create":         {"channels:manage", "groups:write"},
+	"conversations.history":        {"channels:history", "groups:history", "im:history", "mpim:history"},
+	"conversations.info":           {"channels:read", "groups:read", "im:read", "mpim:read"},
+	"conversations.invite":         {"channels:manage", "groups:write", "im:write", "mpim:write"},
+	"conversations.join":           {"channels:join"},
+	"conversations.kick":           {"channels:manage", "groups:write", "im:write", "mpim:write"},
+	"conversations.leave":          {"channels:manage", "groups:write", "im:write", "mpim:write"},
+	"conversations.list":           {"channels:read", "groups:read", "im:read", "mpim:read"},
+	"conversations.mark":           {"channels:manage", "groups:write", "im:write", "mpim:write"},
+	"conversations.members":        {"channels:read", "groups:read", "im:read", "mpim:read"},
+	"conversations.open":           {"channels:manage", "groups:write", "im:write", "mpim:write"},
+	"conversations.rename":         {"channels:manage", "groups:write", "im:write", "mpim:write"},
+	"conversations.replies":        {"channels:history", "groups:history", "im:history", "mpim:history"},
+	"conversations.setPurpose":     {"channels:manage", "groups:write", "im:write", "mpim:write"},
+	"conversations.setTopic":       {"channels:manage", "groups:write", "im:write", "mpim:write"},
+	"conversations.unarchive":      {"channels:manage", "groups:write", "im:write", "mpim:write"},
+	"dnd.info":                     {"dnd:read"},
+	"dnd.teamInfo":                 {"dnd:read"},
+	"emoji.list":                   {"emoji:read"},
+	"files.delete":                 {"files:write"},
+	"files.completeUploadExternal": {"files:write"},
+	"files.getUploadURLExternal":   {"files:write"},
+	"files.info":                   {"files:read"},
+	"files.list":                   {"files:read"},
+	"pins.add":                     {"pins:write"},
+	"pins.list":                    {"pins:read"},
+	"pins.remove":                  {"pins:write"},
+	"reactions.add":                {"reactions:write"},
+	"reactions.get":                {"reactions:read"},
+	"reactions.list":               {"reactions:read"},
+	"reactions.remove":             {"reactions:write"},
+	"reminders.add":                {"reminders:write"},
+	"reminders.complete":           {"reminders:write"},
+	"reminders.delete":             {"reminders:write"},
+	"reminders.info":               {"reminders:read"},
+	"reminders.list":               {"reminders:read"},
+	"search.all":                   {"search:read"},
+	"search.files":                 {"search:read"},
+	"search.messages":              {"search:read"},
+	"team.info":                    {"team:read"},
+	"usergroups.create":            {"usergroups:write"},
+	"usergroups.list":              {"usergroups:read"},
+	"usergroups.update":            {"usergroups:write"},
+	"usergroups.users.list":        {"usergroups:read"},
+	"usergroups.users.update":      {"usergroups:write"},
+	"users.conversations":          {"channels:read", "groups:read", "im:read", "mpim:read"},
+	"users.getPresence":            {"users:read"},
+	"users.info":                   {"users:read"},
+	"users.list":                   {"users:read"},
+	"users.lookupByEmail":          {"users:read.email"},
+	"users.profile.get":            {"users.profile:read"},
+	"users.profile.set":            {"users.profile:write"},
+	"views.open":                   {},
+	"views.publish":                {},
+	"views.push":                   {},
+	"views.update":                 {},
+}
+
+// MissingScopesError is returned by CheckScopes when the token lacks the
+// scopes for some methods. Missing maps each method to the scopes, any one
+// of which would allow calling it.
+type MissingScopesError struct {
+	Missing map[string][]string
+}
+
+func (e MissingScopesError) Error() string {
+	methods := make([]string, 0, len(e.Missing))
+	for method := range e.Missing {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	parts := make([]string, 0, len(methods))
+	for _, method := range methods {
+		parts = append(parts, fmt.Sprintf("%s needs one of %s", method, strings.Join(e.Missing[method], ", ")))
+	}
+	return "missing scopes: " + strings.Join(parts, "; ")
+}
+
+// scopeSet holds the scopes granted to the client token as last reported by
+// the X-OAuth-Scopes response header.
+type scopeSet struct {
+	mu     sync.RWMutex
+	scopes []string
+}
+
+// GrantedScopes returns the scopes granted to the client token, as reported
+// by the X-OAuth-Scopes header of the most recent API response, or nil if
+// no response has reported them yet. Call AuthTest to populate them.
+func (api *Client) GrantedScopes() []string {
+	if api.grantedScopes == nil {
+		return nil
+	}
+	api.grantedScopes.mu.RLock()
+	defer api.grantedScopes.mu.RUnlock()
+	return slices.Clone(api.grantedScopes.scopes)
+}
+
+// recordScopes stores the scopes from headers of a call made with the client
+// token, unless the token was overridden by the context.
+func (api *Client) recordScopes(ctx context.Context, headers http.Header) {
+	if api.grantedScopes == nil || headers == nil {
+		return
+	}
+	if _, ok := ctx.Value(tokenOverrideKey{}).(tokenOverride); ok {
+		return
+	}
+	values, ok := headers[http.CanonicalHeaderKey("X-OAuth-Scopes")]
+	if !ok || len(values) == 0 {
+		return
+	}
+
+	scopes := splitScopes(values[0])
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	api.grantedScopes.mu.Lock()
+	api.grantedScopes.scopes = scopes
+	api.grantedScopes.mu.Unlock()
+}
+
+// CheckScopes verifies that the client token has been granted the scopes
+// required by the given methods, according to MethodScopes. It is meant to
+// be called at startup so that missing scopes are reported before the first
+// failing call. Methods not listed in MethodScopes are not checked.
+//
+// If no scopes have been recorded yet, auth.test is called first.
+func (api *Client) CheckScopes(methods ...string) error {
+	return api.CheckScopesContext(context.Background(), methods...)
+}
+
+// CheckScopesContext verifies the scopes required by the given methods with a
+// custom context.
+func (api *Client) CheckScopesContext(ctx context.Context, methods ...string) error {
+	granted := api.GrantedScopes()
+	if granted == nil {
+		if _, err := api.AuthTestContext(ctx); err != nil {
+			return err
+		}
+		if granted = api.GrantedScopes(); granted == nil {
+			return fmt.Errorf("%w: auth.test did not report the granted scopes", ErrScopesUnknown)
+		}
+	}
+
+	missing := map[string][]string{}
+	for _, method := range methods {
+		required, ok := MethodScopes[method]
+		if !ok || len(required) == 0 {
+			continue
+		}
+		if !slices.ContainsFunc(required, func(scope string) bool { return slices.Contains(granted, scope) }) {
+			missing[method] = required
+		}
+	}
+	if len(missing) > 0 {
+		return MissingScopesError{Missing: missing}
+	}
+	return nil
+}
+
+// splitScopes splits a comma separated scope list.
+func splitScopes(s string) []string {
+	var scopes []string
+	for scope := range strings.SplitSeq(s, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
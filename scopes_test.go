@@ -0,0 +1,79 @@
+package slack
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newScopesServer(t *testing.T, scopes string) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth.test", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("X-OAuth-Scopes", scopes)
+		rw.Write([]byte(`{"ok": true, "team_id": "T1"}`))
+	})
+	mux.HandleFunc("/chat.postMessage", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("X-OAuth-Scopes", scopes)
+		rw.Write([]byte(`{"ok": false, "error": "missing_scope", "needed": "chat:write", "provided": "` + scopes + `"}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCheckScopes(t *testing.T) {
+	server := newScopesServer(t, "channels:read, users:read")
+	api := New("xoxb-test", OptionAPIURL(server.URL+"/"))
+
+	assert.Nil(t, api.GrantedScopes())
+	require.NoError(t, api.CheckScopes("users.info", "conversations.list", "auth.test", "unknown.method"))
+	assert.Equal(t, []string{"channels:read", "users:read"}, api.GrantedScopes())
+
+	err := api.CheckScopes("chat.postMessage", "users.info", "reactions.add")
+	var missing MissingScopesError
+	require.True(t, errors.As(err, &missing))
+	assert.Equal(t, map[string][]string{
+		"chat.postMessage": {"chat:write"},
+		"reactions.add":    {"reactions:write"},
+	}, missing.Missing)
+	assert.Equal(t, "missing scopes: chat.postMessage needs one of chat:write; reactions.add needs one of reactions:write", err.Error())
+}
+
+func TestCheckScopesUnknown(t *testing.T) {
+	server := newScopesServer(t, "")
+	server.Config.Handler = http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	api := New("xoxb-test", OptionAPIURL(server.URL+"/"))
+
+	assert.ErrorIs(t, api.CheckScopes("chat.postMessage"), ErrScopesUnknown)
+}
+
+func TestMissingScopeError(t *testing.T) {
+	server := newScopesServer(t, "channels:read,users:read")
+	api := New("xoxb-test", OptionAPIURL(server.URL+"/"))
+
+	_, _, err := api.PostMessage("C1", MsgOptionText("hi", false))
+	var slackErr SlackErrorResponse
+	require.True(t, errors.As(err, &slackErr))
+	assert.Equal(t, "missing_scope", slackErr.Error())
+	assert.Equal(t, []string{"chat:write"}, slackErr.NeededScopes())
+	assert.Equal(t, []string{"channels:read", "users:read"}, slackErr.ProvidedScopes())
+	assert.Equal(t, []string{"channels:read", "users:read"}, api.GrantedScopes())
+}
+
+func TestGrantedScopesIgnoresOverriddenTokens(t *testing.T) {
+	server := newScopesServer(t, "users:read")
+	api := New("xoxb-test", OptionAPIURL(server.URL+"/"))
+
+	_, err := api.AuthTestContext(WithToken(t.Context(), "xoxb-other"))
+	require.NoError(t, err)
+	assert.Nil(t, api.GrantedScopes())
+}
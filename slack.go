@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
+	"reflect"
+	"strings"
 )
 
 const (
@@ -14,6 +17,8 @@ const (
 	APIURL = "https://slack.com/api/"
 	// AuditAPIURL is the base URL for the Audit Logs API.
 	AuditAPIURL = "https://api.slack.com/"
+	// SCIMAPIURL is the base URL for the SCIM provisioning API.
+	SCIMAPIURL = "https://api.slack.com/scim/"
 	// WEBAPIURLFormat ...
 	WEBAPIURLFormat = "https://%s.slack.com/api/users.admin.%s?t=%d"
 )
@@ -67,11 +72,18 @@ type Client struct {
 	configRefreshToken string
 	endpoint           string
 	auditEndpoint      string
+	scimEndpoint       string
+	scimVersion        SCIMVersion
 	debug              bool
 	log                ilogger
 	httpclient         httpClient
 	onWarning          func(path string, request any, w *Warning)
 	onResponseHeaders  func(path string, headers http.Header)
+	installationStore  InstallationStore
+	tokenRotator       *tokenRotator
+	grantedScopes      *scopeSet
+	apiObserver        APIObserver
+	slog               *slog.Logger
 }
 
 // Option defines an option for a Client
@@ -136,6 +148,17 @@ func OptionAuditAPIURL(u string) func(*Client) {
 	return func(c *Client) { c.auditEndpoint = u }
 }
 
+// OptionSCIMAPIURL set the url for the SCIM API. only useful for testing.
+func OptionSCIMAPIURL(u string) func(*Client) {
+	return func(c *Client) { c.scimEndpoint = u }
+}
+
+// OptionSCIMVersion selects the SCIM API version used by the SCIM methods.
+// Defaults to SCIMVersion1.
+func OptionSCIMVersion(v SCIMVersion) func(*Client) {
+	return func(c *Client) { c.scimVersion = v }
+}
+
 // OptionAppLevelToken sets an app-level token for the client.
 func OptionAppLevelToken(token string) func(*Client) {
 	return func(c *Client) { c.appLevelToken = token }
@@ -190,7 +213,10 @@ func New(token string, options ...Option) *Client {
 		token:         token,
 		endpoint:      APIURL,
 		auditEndpoint: AuditAPIURL,
+		scimEndpoint:  SCIMAPIURL,
+		scimVersion:   SCIMVersion1,
 		httpclient:    &http.Client{},
+		grantedScopes: &scopeSet{},
 		log:           log.New(os.Stderr, "slack-go/slack", log.LstdFlags|log.Lshortfile),
 	}
 
@@ -221,47 +247,139 @@ func (api *Client) AuthTestContext(ctx context.Context) (response *AuthTestRespo
 
 // Debugf print a formatted debug line.
 func (api *Client) Debugf(format string, v ...any) {
-	if api.debug {
+	if api.slog != nil {
+		api.logAttrs(context.Background(), slog.LevelDebug, fmt.Sprintf(format, v...))
+	} else if api.debug {
 		api.log.Output(2, fmt.Sprintf(format, v...))
 	}
 }
 
 // Debugln print a debug line.
 func (api *Client) Debugln(v ...any) {
-	if api.debug {
+	if api.slog != nil {
+		api.logAttrs(context.Background(), slog.LevelDebug, strings.TrimSuffix(fmt.Sprintln(v...), "\n"))
+	} else if api.debug {
 		api.log.Output(2, fmt.Sprintln(v...))
 	}
 }
 
-// Debug returns if debug is enabled.
+// Debug returns if debug is enabled, either with OptionDebug or by a logger
+// set with OptionSlog enabled at debug level.
 func (api *Client) Debug() bool {
-	return api.debug
+	return api.debug || (api.slog != nil && api.slog.Enabled(context.Background(), slog.LevelDebug))
 }
 
-// post to a slack web method.
-func (api *Client) postMethod(ctx context.Context, path string, values url.Values, intf any) error {
-	headers, err := postForm(ctx, api.httpclient, api.endpoint+path, values, intf, api)
-	api.checkWarnings(intf, path, values)
-	api.fireResponseHeaders(path, headers)
-	return err
+// doWithToken makes a call to method with the client token: do sends the
+// request with token. The token is the one given by the context with
+// WithToken, WithBotTokenFor or WithUserTokenFor, if any, or the rotated
+// token with OptionTokenRotation. See doCall for what is done around do.
+//
+// When a rotated token is rejected with token_expired, it is refreshed and
+// do is called once more with intf reset.
+func (api *Client) doWithToken(ctx context.Context, method string, request, intf any, do func(ctx context.Context, token string) (http.Header, error)) error {
+	token, err := api.resolveToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = api.doCall(ctx, method, token, true, request, intf, do)
+	if err != nil || api.tokenRotator == nil || !isTokenExpired(intf) {
+		return err
+	}
+	if _, ok := ctx.Value(tokenOverrideKey{}).(tokenOverride); ok {
+		return nil
+	}
+
+	token, err = api.tokenRotator.refreshExpired(ctx, token)
+	if err != nil {
+		return err
+	}
+	if v := reflect.ValueOf(intf); v.Kind() == reflect.Pointer && !v.IsNil() {
+		v.Elem().SetZero()
+	}
+	return api.doCall(ctx, method, token, true, request, intf, do)
 }
 
-// get a slack web method.
-func (api *Client) getMethod(ctx context.Context, path string, token string, values url.Values, intf any) error {
-	headers, err := getResource(ctx, api.httpclient, api.endpoint+path, token, values, intf, api)
-	api.checkWarnings(intf, path, values)
-	api.fireResponseHeaders(path, headers)
-	return err
+// doWithExplicitToken is like doWithToken for calls made with a token other
+// than the client token, e.g. the app-level or configuration token, or no
+// token at all. The token is sent as is.
+func (api *Client) doWithExplicitToken(ctx context.Context, method, token string, request, intf any, do func(ctx context.Context, token string) (http.Header, error)) error {
+	return api.doCall(ctx, method, token, false, request, intf, do)
 }
 
-// postJSONMethod posts JSON to a slack web method.
-func (api *Client) postJSONMethod(ctx context.Context, path string, token string, jsonBody []byte, intf any) error {
-	headers, err := postJSON(ctx, api.httpclient, api.endpoint+path, token, jsonBody, intf, api)
-	api.checkWarnings(intf, path, jsonBody)
-	api.fireResponseHeaders(path, headers)
+// doCall checks that token can be used to call method, then calls do,
+// reporting the call to the API observer along with request. The warnings
+// and headers of the response are passed to the callbacks of the client, and
+// the granted scopes are recorded for the client token.
+func (api *Client) doCall(ctx context.Context, method, token string, client bool, request, intf any, do func(ctx context.Context, token string) (http.Header, error)) error {
+	if err := checkTokenType(method, token); err != nil {
+		return err
+	}
+	callCtx, done := api.startCall(ctx, method, request)
+	headers, err := do(callCtx, token)
+	done(intf, headers, err)
+	api.checkWarnings(intf, method, request)
+	api.fireResponseHeaders(method, headers)
+	if client {
+		api.recordScopes(ctx, headers)
+	}
 	return err
 }
 
+// post to a slack web method. Calls whose values have a token are made with
+// the client token, see doWithToken.
+func (api *Client) postMethod(ctx context.Context, path string, values url.Values, intf any) error {
+	post := func(ctx context.Context, token string) (http.Header, error) {
+		form := values
+		if token != "" {
+			form = valuesWithToken(values, token)
+		}
+		return postForm(ctx, api.httpclient, api.endpoint+path, form, intf, api)
+	}
+	if _, ok := values["token"]; !ok {
+		return api.doWithExplicitToken(ctx, path, "", values, intf, post)
+	}
+	return api.doWithToken(ctx, path, values, intf, post)
+}
+
+// postMethodWithExplicitToken posts to a slack web method with token rather
+// than the client token.
+func (api *Client) postMethodWithExplicitToken(ctx context.Context, path, token string, values url.Values, intf any) error {
+	return api.doWithExplicitToken(ctx, path, token, values, intf, func(ctx context.Context, token string) (http.Header, error) {
+		return postForm(ctx, api.httpclient, api.endpoint+path, valuesWithToken(values, token), intf, api)
+	})
+}
+
+// get a slack web method with the client token.
+func (api *Client) getMethod(ctx context.Context, path string, values url.Values, intf any) error {
+	return api.doWithToken(ctx, path, values, intf, func(ctx context.Context, token string) (http.Header, error) {
+		return getResource(ctx, api.httpclient, api.endpoint+path, token, values, intf, api)
+	})
+}
+
+// getMethodWithExplicitToken gets a slack web method with token rather than
+// the client token.
+func (api *Client) getMethodWithExplicitToken(ctx context.Context, path, token string, values url.Values, intf any) error {
+	return api.doWithExplicitToken(ctx, path, token, values, intf, func(ctx context.Context, token string) (http.Header, error) {
+		return getResource(ctx, api.httpclient, api.endpoint+path, token, values, intf, api)
+	})
+}
+
+// postJSONMethod posts JSON to a slack web method with the client token.
+func (api *Client) postJSONMethod(ctx context.Context, path string, jsonBody []byte, intf any) error {
+	return api.doWithToken(ctx, path, jsonBody, intf, func(ctx context.Context, token string) (http.Header, error) {
+		return postJSON(ctx, api.httpclient, api.endpoint+path, token, jsonBody, intf, api)
+	})
+}
+
+// postJSONMethodWithExplicitToken posts JSON to a slack web method with token
+// rather than the client token.
+func (api *Client) postJSONMethodWithExplicitToken(ctx context.Context, path, token string, jsonBody []byte, intf any) error {
+	return api.doWithExplicitToken(ctx, path, token, jsonBody, intf, func(ctx context.Context, token string) (http.Header, error) {
+		return postJSON(ctx, api.httpclient, api.endpoint+path, token, jsonBody, intf, api)
+	})
+}
+
 func (api *Client) checkWarnings(intf any, path string, request any) {
 	if api.onWarning == nil {
 		return
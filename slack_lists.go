@@ -0,0 +1,557 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// SlackListColumnType is the type of a list column.
+type SlackListColumnType string
+
+const (
+	SlackListColumnTypeText          SlackListColumnType = "text"
+	SlackListColumnTypeRichText      SlackListColumnType = "rich_text"
+	SlackListColumnTypeNumber        SlackListColumnType = "number"
+	SlackListColumnTypeSelect        SlackListColumnType = "select"
+	SlackListColumnTypeDate          SlackListColumnType = "date"
+	SlackListColumnTypeUser          SlackListColumnType = "user"
+	SlackListColumnTypeChannel       SlackListColumnType = "channel"
+	SlackListColumnTypeCheckbox      SlackListColumnType = "checkbox"
+	SlackListColumnTypeEmail         SlackListColumnType = "email"
+	SlackListColumnTypePhone         SlackListColumnType = "phone"
+	SlackListColumnTypeLink          SlackListColumnType = "link"
+	SlackListColumnTypeAttachment    SlackListColumnType = "attachment"
+	SlackListColumnTypeRating        SlackListColumnType = "rating"
+	SlackListColumnTypeTimestamp     SlackListColumnType = "timestamp"
+	SlackListColumnTypeVote          SlackListColumnType = "vote"
+	SlackListColumnTypeCanvas        SlackListColumnType = "canvas"
+	SlackListColumnTypeTodoAssignee  SlackListColumnType = "todo_assignee"
+	SlackListColumnTypeTodoDueDate   SlackListColumnType = "todo_due_date"
+	SlackListColumnTypeTodoCompleted SlackListColumnType = "todo_completed"
+)
+
+// SlackListColumnChoice is an option of a select column.
+type SlackListColumnChoice struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+	Color string `json:"color,omitempty"`
+}
+
+// SlackListColumnOptions holds type specific settings of a list column.
+type SlackListColumnOptions struct {
+	Choices        []SlackListColumnChoice `json:"choices,omitempty"`
+	Format         string                  `json:"format,omitempty"`
+	Precision      *int                    `json:"precision,omitempty"`
+	Emoji          string                  `json:"emoji,omitempty"`
+	Max            int                     `json:"max,omitempty"`
+	ShowMemberName bool                    `json:"show_member_name,omitempty"`
+	NotifyUsers    bool                    `json:"notify_users,omitempty"`
+}
+
+// SlackListColumn describes a column of a list schema.
+type SlackListColumn struct {
+	ID              string                  `json:"id,omitempty"`
+	Key             string                  `json:"key"`
+	Name            string                  `json:"name"`
+	Type            SlackListColumnType     `json:"type"`
+	IsPrimaryColumn bool                    `json:"is_primary_column,omitempty"`
+	Options         *SlackListColumnOptions `json:"options,omitempty"`
+}
+
+// SlackListMetadata holds the schema of a list and of its subtasks.
+type SlackListMetadata struct {
+	Schema        []SlackListColumn `json:"schema"`
+	SubtaskSchema []SlackListColumn `json:"subtask_schema,omitempty"`
+}
+
+// SlackListLink is the value of a link field.
+type SlackListLink struct {
+	OriginalURL string `json:"original_url"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+// SlackListField is the value of a single column of a list item. Only the
+// member matching the column type is set. Text is a plain text rendering
+// returned by Slack; use RichText to write text columns.
+type SlackListField struct {
+	Key        string           `json:"key,omitempty"`
+	ColumnID   string           `json:"column_id"`
+	Value      string           `json:"value,omitempty"`
+	Text       string           `json:"text,omitempty"`
+	RichText   []*RichTextBlock `json:"rich_text,omitempty"`
+	Number     []float64        `json:"number,omitempty"`
+	Select     []string         `json:"select,omitempty"`
+	Date       []string         `json:"date,omitempty"`
+	User       []string         `json:"user,omitempty"`
+	Channel    []string         `json:"channel,omitempty"`
+	Checkbox   *bool            `json:"checkbox,omitempty"`
+	Email      []string         `json:"email,omitempty"`
+	Phone      []string         `json:"phone,omitempty"`
+	Link       []SlackListLink  `json:"link,omitempty"`
+	Attachment []string         `json:"attachment,omitempty"`
+	Rating     []int            `json:"rating,omitempty"`
+	Timestamp  []int64          `json:"timestamp,omitempty"`
+}
+
+// NewSlackListTextField returns a text field value for the given column.
+func NewSlackListTextField(columnID, text string) SlackListField {
+	return SlackListField{
+		ColumnID: columnID,
+		RichText: []*RichTextBlock{
+			NewRichTextBlock("", NewRichTextSection(NewRichTextSectionTextElement(text, nil))),
+		},
+	}
+}
+
+// NewSlackListNumberField returns a number field value for the given column.
+func NewSlackListNumberField(columnID string, number float64) SlackListField {
+	return SlackListField{ColumnID: columnID, Number: []float64{number}}
+}
+
+// NewSlackListSelectField returns a select field value for the given column.
+func NewSlackListSelectField(columnID string, choices ...string) SlackListField {
+	return SlackListField{ColumnID: columnID, Select: choices}
+}
+
+// NewSlackListDateField returns a date field value (YYYY-MM-DD) for the given column.
+func NewSlackListDateField(columnID string, date string) SlackListField {
+	return SlackListField{ColumnID: columnID, Date: []string{date}}
+}
+
+// NewSlackListUserField returns a user field value for the given column.
+func NewSlackListUserField(columnID string, userIDs ...string) SlackListField {
+	return SlackListField{ColumnID: columnID, User: userIDs}
+}
+
+// NewSlackListCheckboxField returns a checkbox field value for the given column.
+func NewSlackListCheckboxField(columnID string, checked bool) SlackListField {
+	return SlackListField{ColumnID: columnID, Checkbox: &checked}
+}
+
+// SlackListItem is an item (row) of a list.
+type SlackListItem struct {
+	ID               string           `json:"id"`
+	ListID           string           `json:"list_id"`
+	ParentItemID     string           `json:"parent_item_id,omitempty"`
+	DateCreated      int64            `json:"date_created"`
+	CreatedBy        string           `json:"created_by"`
+	UpdatedBy        string           `json:"updated_by"`
+	UpdatedTimestamp string           `json:"updated_timestamp"`
+	IsSubscribed     bool             `json:"is_subscribed,omitempty"`
+	Fields           []SlackListField `json:"fields"`
+}
+
+// SlackListCell is a field value of an existing item, as used by
+// UpdateSlackListItems.
+type SlackListCell struct {
+	RowID string `json:"row_id"`
+	SlackListField
+}
+
+// SlackListItemInfo is the response of slackLists.items.info.
+type SlackListItemInfo struct {
+	List     File            `json:"list"`
+	Record   SlackListItem   `json:"record"`
+	Subtasks []SlackListItem `json:"subtasks"`
+}
+
+// SlackListDownload is the state of a list export job.
+type SlackListDownload struct {
+	Status      string `json:"status"`
+	DownloadURL string `json:"download_url"`
+}
+
+type CreateSlackListParams struct {
+	Name                     string            `json:"name"`
+	DescriptionBlocks        []*RichTextBlock  `json:"description_blocks,omitempty"`
+	Schema                   []SlackListColumn `json:"schema,omitempty"`
+	CopyFromListID           string            `json:"copy_from_list_id,omitempty"`
+	IncludeCopiedListRecords bool              `json:"include_copied_list_records,omitempty"`
+	TodoMode                 bool              `json:"todo_mode,omitempty"`
+}
+
+type CreateSlackListItemParams struct {
+	ListID           string           `json:"list_id"`
+	ParentItemID     string           `json:"parent_item_id,omitempty"`
+	DuplicatedItemID string           `json:"duplicated_item_id,omitempty"`
+	InitialFields    []SlackListField `json:"initial_fields,omitempty"`
+}
+
+type GetSlackListItemsParams struct {
+	ListID   string `json:"list_id"`
+	Cursor   string `json:"cursor,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+	Archived bool   `json:"archived,omitempty"`
+}
+
+type UpdateSlackListItemsParams struct {
+	ListID string          `json:"list_id"`
+	Cells  []SlackListCell `json:"cells"`
+}
+
+type SetSlackListAccessParams struct {
+	ListID      string   `json:"list_id"`
+	AccessLevel string   `json:"access_level"`
+	ChannelIDs  []string `json:"channel_ids,omitempty"`
+	UserIDs     []string `json:"user_ids,omitempty"`
+}
+
+type DeleteSlackListAccessParams struct {
+	ListID     string   `json:"list_id"`
+	ChannelIDs []string `json:"channel_ids,omitempty"`
+	UserIDs    []string `json:"user_ids,omitempty"`
+}
+
+// addJSONValue adds the JSON encoding of v to values under key.
+func addJSONValue(values url.Values, key string, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	values.Add(key, string(b))
+	return nil
+}
+
+// CreateSlackList creates a new list.
+// For more details, see CreateSlackListContext documentation.
+func (api *Client) CreateSlackList(params CreateSlackListParams) (string, *SlackListMetadata, error) {
+	return api.CreateSlackListContext(context.Background(), params)
+}
+
+// CreateSlackListContext creates a new list with a custom context and returns
+// its ID and schema.
+// Slack API docs: https://docs.slack.dev/reference/methods/slackLists.create
+func (api *Client) CreateSlackListContext(ctx context.Context, params CreateSlackListParams) (string, *SlackListMetadata, error) {
+	values := url.Values{
+		"token": {api.token},
+		"name":  {params.Name},
+	}
+	if len(params.DescriptionBlocks) > 0 {
+		if err := addJSONValue(values, "description_blocks", params.DescriptionBlocks); err != nil {
+			return "", nil, err
+		}
+	}
+	if len(params.Schema) > 0 {
+		if err := addJSONValue(values, "schema", params.Schema); err != nil {
+			return "", nil, err
+		}
+	}
+	if params.CopyFromListID != "" {
+		values.Add("copy_from_list_id", params.CopyFromListID)
+	}
+	if params.IncludeCopiedListRecords {
+		values.Add("include_copied_list_records", "true")
+	}
+	if params.TodoMode {
+		values.Add("todo_mode", "true")
+	}
+
+	response := struct {
+		SlackResponse
+		ListID       string            `json:"list_id"`
+		ListMetadata SlackListMetadata `json:"list_metadata"`
+	}{}
+
+	err := api.postMethod(ctx, "slackLists.create", values, &response)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return response.ListID, &response.ListMetadata, response.Err()
+}
+
+// CreateSlackListItem adds an item to a list.
+// For more details, see CreateSlackListItemContext documentation.
+func (api *Client) CreateSlackListItem(params CreateSlackListItemParams) (*SlackListItem, error) {
+	return api.CreateSlackListItemContext(context.Background(), params)
+}
+
+// CreateSlackListItemContext adds an item to a list with a custom context.
+// Slack API docs: https://docs.slack.dev/reference/methods/slackLists.items.create
+func (api *Client) CreateSlackListItemContext(ctx context.Context, params CreateSlackListItemParams) (*SlackListItem, error) {
+	values := url.Values{
+		"token":   {api.token},
+		"list_id": {params.ListID},
+	}
+	if params.ParentItemID != "" {
+		values.Add("parent_item_id", params.ParentItemID)
+	}
+	if params.DuplicatedItemID != "" {
+		values.Add("duplicated_item_id", params.DuplicatedItemID)
+	}
+	if len(params.InitialFields) > 0 {
+		if err := addJSONValue(values, "initial_fields", params.InitialFields); err != nil {
+			return nil, err
+		}
+	}
+
+	response := struct {
+		SlackResponse
+		Item SlackListItem `json:"item"`
+	}{}
+
+	err := api.postMethod(ctx, "slackLists.items.create", values, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Item, response.Err()
+}
+
+// GetSlackListItems returns a page of list items.
+// For more details, see GetSlackListItemsContext documentation.
+func (api *Client) GetSlackListItems(params GetSlackListItemsParams) ([]SlackListItem, string, error) {
+	return api.GetSlackListItemsContext(context.Background(), params)
+}
+
+// GetSlackListItemsContext returns a page of list items with a custom
+// context, along with the cursor of the next page, which is empty on the
+// last page.
+// Slack API docs: https://docs.slack.dev/reference/methods/slackLists.items.list
+func (api *Client) GetSlackListItemsContext(ctx context.Context, params GetSlackListItemsParams) ([]SlackListItem, string, error) {
+	values := url.Values{
+		"token":   {api.token},
+		"list_id": {params.ListID},
+	}
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		values.Add("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Archived {
+		values.Add("archived", "true")
+	}
+
+	response := struct {
+		SlackResponse
+		Items []SlackListItem `json:"items"`
+	}{}
+
+	err := api.postMethod(ctx, "slackLists.items.list", values, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response.Items, response.ResponseMetadata.Cursor, response.Err()
+}
+
+// GetSlackListItemInfo returns an item along with its list and subtasks.
+// For more details, see GetSlackListItemInfoContext documentation.
+func (api *Client) GetSlackListItemInfo(listID, itemID string) (*SlackListItemInfo, error) {
+	return api.GetSlackListItemInfoContext(context.Background(), listID, itemID)
+}
+
+// GetSlackListItemInfoContext returns an item along with its list and
+// subtasks with a custom context.
+// Slack API docs: https://docs.slack.dev/reference/methods/slackLists.items.info
+func (api *Client) GetSlackListItemInfoContext(ctx context.Context, listID, itemID string) (*SlackListItemInfo, error) {
+	values := url.Values{
+		"token":   {api.token},
+		"list_id": {listID},
+		"id":      {itemID},
+	}
+
+	response := struct {
+		SlackResponse
+		SlackListItemInfo
+	}{}
+
+	err := api.postMethod(ctx, "slackLists.items.info", values, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.SlackListItemInfo, response.Err()
+}
+
+// UpdateSlackListItems updates cells of one or more list items.
+// For more details, see UpdateSlackListItemsContext documentation.
+func (api *Client) UpdateSlackListItems(params UpdateSlackListItemsParams) error {
+	return api.UpdateSlackListItemsContext(context.Background(), params)
+}
+
+// UpdateSlackListItemsContext updates cells of one or more list items with a custom context.
+// Slack API docs: https://docs.slack.dev/reference/methods/slackLists.items.update
+func (api *Client) UpdateSlackListItemsContext(ctx context.Context, params UpdateSlackListItemsParams) error {
+	values := url.Values{
+		"token":   {api.token},
+		"list_id": {params.ListID},
+	}
+	if err := addJSONValue(values, "cells", params.Cells); err != nil {
+		return err
+	}
+
+	response := struct {
+		SlackResponse
+	}{}
+
+	err := api.postMethod(ctx, "slackLists.items.update", values, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// DeleteSlackListItem deletes an item from a list.
+// For more details, see DeleteSlackListItemContext documentation.
+func (api *Client) DeleteSlackListItem(listID, itemID string) error {
+	return api.DeleteSlackListItemContext(context.Background(), listID, itemID)
+}
+
+// DeleteSlackListItemContext deletes an item from a list with a custom context.
+// Slack API docs: https://docs.slack.dev/reference/methods/slackLists.items.delete
+func (api *Client) DeleteSlackListItemContext(ctx context.Context, listID, itemID string) error {
+	values := url.Values{
+		"token":   {api.token},
+		"list_id": {listID},
+		"id":      {itemID},
+	}
+
+	response := struct {
+		SlackResponse
+	}{}
+
+	err := api.postMethod(ctx, "slackLists.items.delete", values, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// SetSlackListAccess sets the access level to a list for specified entities.
+// For more details, see SetSlackListAccessContext documentation.
+func (api *Client) SetSlackListAccess(params SetSlackListAccessParams) error {
+	return api.SetSlackListAccessContext(context.Background(), params)
+}
+
+// SetSlackListAccessContext sets the access level to a list for specified entities with a custom context.
+// Slack API docs: https://docs.slack.dev/reference/methods/slackLists.access.set
+func (api *Client) SetSlackListAccessContext(ctx context.Context, params SetSlackListAccessParams) error {
+	values := url.Values{
+		"token":        {api.token},
+		"list_id":      {params.ListID},
+		"access_level": {params.AccessLevel},
+	}
+	if len(params.ChannelIDs) > 0 {
+		if err := addJSONValue(values, "channel_ids", params.ChannelIDs); err != nil {
+			return err
+		}
+	}
+	if len(params.UserIDs) > 0 {
+		if err := addJSONValue(values, "user_ids", params.UserIDs); err != nil {
+			return err
+		}
+	}
+
+	response := struct {
+		SlackResponse
+	}{}
+
+	err := api.postMethod(ctx, "slackLists.access.set", values, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// DeleteSlackListAccess removes access to a list for specified entities.
+// For more details, see DeleteSlackListAccessContext documentation.
+func (api *Client) DeleteSlackListAccess(params DeleteSlackListAccessParams) error {
+	return api.DeleteSlackListAccessContext(context.Background(), params)
+}
+
+// DeleteSlackListAccessContext removes access to a list for specified entities with a custom context.
+// Slack API docs: https://docs.slack.dev/reference/methods/slackLists.access.delete
+func (api *Client) DeleteSlackListAccessContext(ctx context.Context, params DeleteSlackListAccessParams) error {
+	values := url.Values{
+		"token":   {api.token},
+		"list_id": {params.ListID},
+	}
+	if len(params.ChannelIDs) > 0 {
+		if err := addJSONValue(values, "channel_ids", params.ChannelIDs); err != nil {
+			return err
+		}
+	}
+	if len(params.UserIDs) > 0 {
+		if err := addJSONValue(values, "user_ids", params.UserIDs); err != nil {
+			return err
+		}
+	}
+
+	response := struct {
+		SlackResponse
+	}{}
+
+	err := api.postMethod(ctx, "slackLists.access.delete", values, &response)
+	if err != nil {
+		return err
+	}
+
+	return response.Err()
+}
+
+// StartSlackListDownload starts an export job for a list.
+// For more details, see StartSlackListDownloadContext documentation.
+func (api *Client) StartSlackListDownload(listID string, includeArchived bool) (string, error) {
+	return api.StartSlackListDownloadContext(context.Background(), listID, includeArchived)
+}
+
+// StartSlackListDownloadContext starts an export job for a list with a
+// custom context and returns the job ID to poll with GetSlackListDownload.
+// Slack API docs: https://docs.slack.dev/reference/methods/slackLists.download.start
+func (api *Client) StartSlackListDownloadContext(ctx context.Context, listID string, includeArchived bool) (string, error) {
+	values := url.Values{
+		"token":   {api.token},
+		"list_id": {listID},
+	}
+	if includeArchived {
+		values.Add("include_archived", "true")
+	}
+
+	response := struct {
+		SlackResponse
+		JobID string `json:"job_id"`
+	}{}
+
+	err := api.postMethod(ctx, "slackLists.download.start", values, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.JobID, response.Err()
+}
+
+// GetSlackListDownload returns the state of a list export job.
+// For more details, see GetSlackListDownloadContext documentation.
+func (api *Client) GetSlackListDownload(listID, jobID string) (*SlackListDownload, error) {
+	return api.GetSlackListDownloadContext(context.Background(), listID, jobID)
+}
+
+// GetSlackListDownloadContext returns the state of a list export job with a
+// custom context. DownloadURL is set once Status is "completed".
+// Slack API docs: https://docs.slack.dev/reference/methods/slackLists.download.get
+func (api *Client) GetSlackListDownloadContext(ctx context.Context, listID, jobID string) (*SlackListDownload, error) {
+	values := url.Values{
+		"token":   {api.token},
+		"list_id": {listID},
+		"job_id":  {jobID},
+	}
+
+	response := struct {
+		SlackResponse
+		SlackListDownload
+	}{}
+
+	err := api.postMethod(ctx, "slackLists.download.get", values, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.SlackListDownload, response.Err()
+}
@@ -0,0 +1,239 @@
+package slack
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSlackList(t *testing.T) {
+	var schema []SlackListColumn
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/slackLists.create", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Incidents", r.FormValue("name"))
+		assert.Equal(t, "true", r.FormValue("todo_mode"))
+		assert.NoError(t, json.Unmarshal([]byte(r.FormValue("schema")), &schema))
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{
+			"ok": true,
+			"list_id": "F1234ABCD",
+			"list_metadata": {
+				"schema": [
+					{"id": "Col1", "key": "title", "name": "Title", "type": "text", "is_primary_column": true},
+					{"id": "Col2", "key": "severity", "name": "Severity", "type": "select",
+					 "options": {"choices": [{"value": "sev1", "label": "SEV1", "color": "red"}]}}
+				]
+			}
+		}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	listID, metadata, err := api.CreateSlackList(CreateSlackListParams{
+		Name:     "Incidents",
+		TodoMode: true,
+		Schema: []SlackListColumn{
+			{Key: "title", Name: "Title", Type: SlackListColumnTypeText, IsPrimaryColumn: true},
+			{Key: "severity", Name: "Severity", Type: SlackListColumnTypeSelect, Options: &SlackListColumnOptions{
+				Choices: []SlackListColumnChoice{{Value: "sev1", Label: "SEV1", Color: "red"}},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, schema, 2)
+	assert.Equal(t, SlackListColumnTypeSelect, schema[1].Type)
+	assert.Equal(t, "sev1", schema[1].Options.Choices[0].Value)
+	assert.Equal(t, "F1234ABCD", listID)
+	require.Len(t, metadata.Schema, 2)
+	assert.Equal(t, "Col1", metadata.Schema[0].ID)
+	assert.True(t, metadata.Schema[0].IsPrimaryColumn)
+}
+
+func TestCreateSlackListItem(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/slackLists.items.create", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "F1234ABCD", r.FormValue("list_id"))
+		assert.JSONEq(t, `[
+			{"column_id": "Col1", "rich_text": [{"type": "rich_text", "elements": [{"type": "rich_text_section", "elements": [{"type": "text", "text": "DB outage"}]}]}]},
+			{"column_id": "Col2", "select": ["sev1"]},
+			{"column_id": "Col3", "checkbox": false}
+		]`, r.FormValue("initial_fields"))
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{
+			"ok": true,
+			"item": {
+				"id": "Rec123",
+				"list_id": "F1234ABCD",
+				"date_created": 1700000000,
+				"created_by": "U123",
+				"fields": [
+					{"key": "title", "column_id": "Col1", "value": "DB outage", "text": "DB outage"},
+					{"key": "severity", "column_id": "Col2", "value": "sev1", "select": ["sev1"]}
+				]
+			}
+		}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	item, err := api.CreateSlackListItem(CreateSlackListItemParams{
+		ListID: "F1234ABCD",
+		InitialFields: []SlackListField{
+			NewSlackListTextField("Col1", "DB outage"),
+			NewSlackListSelectField("Col2", "sev1"),
+			NewSlackListCheckboxField("Col3", false),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Rec123", item.ID)
+	require.Len(t, item.Fields, 2)
+	assert.Equal(t, "DB outage", item.Fields[0].Text)
+	assert.Equal(t, []string{"sev1"}, item.Fields[1].Select)
+}
+
+func TestGetSlackListItems(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/slackLists.items.list", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "F1234ABCD", r.FormValue("list_id"))
+		assert.Equal(t, "50", r.FormValue("limit"))
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("cursor") == "" {
+			rw.Write([]byte(`{"ok": true, "items": [{"id": "Rec1"}], "response_metadata": {"next_cursor": "page2"}}`))
+			return
+		}
+		rw.Write([]byte(`{"ok": true, "items": [{"id": "Rec2"}], "response_metadata": {"next_cursor": ""}}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	var ids []string
+	params := GetSlackListItemsParams{ListID: "F1234ABCD", Limit: 50}
+	for {
+		items, cursor, err := api.GetSlackListItems(params)
+		require.NoError(t, err)
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+		if cursor == "" {
+			break
+		}
+		params.Cursor = cursor
+	}
+	assert.Equal(t, []string{"Rec1", "Rec2"}, ids)
+}
+
+func TestGetSlackListItemInfo(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/slackLists.items.info", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Rec1", r.FormValue("id"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{
+			"ok": true,
+			"list": {"id": "F1234ABCD", "name": "Incidents"},
+			"record": {"id": "Rec1", "list_id": "F1234ABCD"},
+			"subtasks": [{"id": "Rec2", "parent_item_id": "Rec1"}]
+		}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	info, err := api.GetSlackListItemInfo("F1234ABCD", "Rec1")
+	require.NoError(t, err)
+	assert.Equal(t, "Incidents", info.List.Name)
+	assert.Equal(t, "Rec1", info.Record.ID)
+	require.Len(t, info.Subtasks, 1)
+	assert.Equal(t, "Rec1", info.Subtasks[0].ParentItemID)
+}
+
+func TestUpdateSlackListItems(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/slackLists.items.update", func(rw http.ResponseWriter, r *http.Request) {
+		assert.JSONEq(t, `[{"row_id": "Rec1", "column_id": "Col4", "number": [3]}]`, r.FormValue("cells"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	err := api.UpdateSlackListItems(UpdateSlackListItemsParams{
+		ListID: "F1234ABCD",
+		Cells:  []SlackListCell{{RowID: "Rec1", SlackListField: NewSlackListNumberField("Col4", 3)}},
+	})
+	require.NoError(t, err)
+}
+
+func TestDeleteSlackListItem(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/slackLists.items.delete", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": false, "error": "item_not_found"}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	err := api.DeleteSlackListItem("F1234ABCD", "Rec9")
+	require.EqualError(t, err, "item_not_found")
+}
+
+func TestSetSlackListAccess(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/slackLists.access.set", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "write", r.FormValue("access_level"))
+		assert.Equal(t, `["C123"]`, r.FormValue("channel_ids"))
+		assert.Empty(t, r.FormValue("user_ids"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	err := api.SetSlackListAccess(SetSlackListAccessParams{
+		ListID:      "F1234ABCD",
+		AccessLevel: "write",
+		ChannelIDs:  []string{"C123"},
+	})
+	require.NoError(t, err)
+}
+
+func TestDeleteSlackListAccess(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/slackLists.access.delete", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `["U123"]`, r.FormValue("user_ids"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	err := api.DeleteSlackListAccess(DeleteSlackListAccessParams{ListID: "F1234ABCD", UserIDs: []string{"U123"}})
+	require.NoError(t, err)
+}
+
+func TestSlackListDownload(t *testing.T) {
+	http.DefaultServeMux = new(http.ServeMux)
+	http.HandleFunc("/slackLists.download.start", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.FormValue("include_archived"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "job_id": "LeF123"}`))
+	})
+	http.HandleFunc("/slackLists.download.get", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "LeF123", r.FormValue("job_id"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "status": "completed", "download_url": "https://files.slack.com/list.csv"}`))
+	})
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	jobID, err := api.StartSlackListDownload("F1234ABCD", true)
+	require.NoError(t, err)
+	assert.Equal(t, "LeF123", jobID)
+
+	download, err := api.GetSlackListDownload("F1234ABCD", jobID)
+	require.NoError(t, err)
+	assert.Equal(t, &SlackListDownload{Status: "completed", DownloadURL: "https://files.slack.com/list.csv"}, download)
+}
@@ -1,9 +1,14 @@
 package slack
 
 import (
+	"encoding/json"
 	"log"
+	"net/http"
 	"net/http/httptest"
 	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -20,3 +25,20 @@ func startServer() {
 	serverAddr = server.Listener.Addr().String()
 	log.Print("Test WebSocket server listening on ", serverAddr)
 }
+
+// mockJSONHandler checks that a JSON API method is called with the test token
+// and the wantRequest body, and answers with response.
+func mockJSONHandler(t *testing.T, wantRequest string, response string) func(rw http.ResponseWriter, r *http.Request) {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer "+validToken, r.Header.Get("Authorization"))
+		var body json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); !assert.NoError(t, err) {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		assert.JSONEq(t, wantRequest, string(body))
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(response))
+	}
+}
@@ -0,0 +1,36 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// OptionSlog sets a structured logger for the client and the RTM connections
+// created from it. API calls, retries and connection changes are logged with
+// attributes such as the API method and retry attempt, at debug level when
+// they succeed and warn level otherwise. Debug output is sent to it at debug
+// level regardless of OptionDebug. Tokens are redacted from messages.
+func OptionSlog(l *slog.Logger) func(*Client) {
+	return func(c *Client) {
+		c.slog = l
+	}
+}
+
+// logAttrs logs to the slog.Logger set with OptionSlog, if any.
+func (api *Client) logAttrs(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	if api.slog == nil || !api.slog.Enabled(ctx, level) {
+		return
+	}
+	api.slog.LogAttrs(ctx, level, string(redactToken([]byte(msg))), attrs...)
+}
+
+// logAt formats a line as Debugf does. With a slog.Logger set, the line is
+// logged at level along with attrs instead.
+func (api *Client) logAt(level slog.Level, attrs []slog.Attr, format string, v ...any) {
+	if api.slog != nil {
+		api.logAttrs(context.Background(), level, fmt.Sprintf(format, v...), attrs...)
+	} else if api.debug {
+		api.log.Output(3, fmt.Sprintf(format, v...))
+	}
+}
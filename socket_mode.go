@@ -22,7 +22,7 @@ type openResponseFull struct {
 // To have a fully managed Socket Mode connection, use `socketmode.New()`, and call `Run()` on it.
 func (api *Client) StartSocketModeContext(ctx context.Context) (info *SocketModeConnection, websocketURL string, err error) {
 	response := &openResponseFull{}
-	err = api.postJSONMethod(ctx, "apps.connections.open", api.appLevelToken, nil, response)
+	err = api.postJSONMethodWithExplicitToken(ctx, "apps.connections.open", api.appLevelToken, nil, response)
 	if err != nil {
 		return nil, "", err
 	}
@@ -2,6 +2,7 @@ package socketmode
 
 import (
 	"encoding/json"
+	"log/slog"
 	"time"
 
 	"github.com/slack-go/slack"
@@ -12,6 +13,9 @@ import (
 type ConnectedEvent struct {
 	ConnectionCount int // 1 = first time, 2 = second time
 	Info            *slack.SocketModeConnection
+	// Slot identifies the connection when OptionConnections is used, from 0
+	// to the number of connections minus one.
+	Slot int
 }
 
 type DebugInfo struct {
@@ -58,6 +62,18 @@ type Client struct {
 	// Dialer.
 	dialer *websocket.Dialer
 
+	// connections is the number of WebSocket connections kept open by
+	// OptionConnections. Zero keeps the single connection behavior.
+	connections int
+
+	onUnacked func(unacked []*Request)
+	state     runState
+
+	largeAckFallback bool
+
+	observer slack.ConnectionObserver
+
 	debug bool
 	log   ilogger
+	slog  *slog.Logger
 }
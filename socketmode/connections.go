@@ -0,0 +1,204 @@
+package socketmode
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+const (
+	// maxConnections is the number of Socket Mode connections Slack allows
+	// per app.
+	maxConnections = 10
+
+	// envelopeDedupeWindow is how long an envelope is remembered to drop
+	// copies delivered on other connections.
+	envelopeDedupeWindow = 5 * time.Minute
+)
+
+// OptionConnections makes the client keep n WebSocket connections open, up to
+// the 10 Slack allows per app. Slack delivers each envelope on one of them,
+// so a connection being refreshed does not delay events.
+//
+// With this option, a connection that receives a `disconnect` request is only
+// closed once its replacement is open, and envelopes delivered on more than one
+// connection are passed to Events once. Responses are written to whichever
+// connection is free.
+func OptionConnections(n int) Option {
+	return func(smc *Client) {
+		smc.connections = min(max(n, 1), maxConnections)
+	}
+}
+
+// runConnections keeps smc.connections connections open until ctx is
+// cancelled or one of them fails to reconnect.
+func (smc *Client) runConnections(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	seen := newEnvelopeSet(envelopeDedupeWindow)
+	errc := make(chan error, smc.connections)
+
+	wg := new(sync.WaitGroup)
+	for slot := range smc.connections {
+		wg.Go(func() {
+			if err := smc.maintainConnection(ctx, slot, seen); err != nil {
+				errc <- err
+				cancel()
+			}
+		})
+	}
+	wg.Wait()
+
+	select {
+	case err := <-errc:
+		return err
+	default:
+		return ctx.Err()
+	}
+}
+
+// maintainConnection keeps one connection open, reconnecting when it fails
+// and opening a replacement before closing it when Slack requests it.
+func (smc *Client) maintainConnection(ctx context.Context, slot int, seen *envelopeSet) error {
+	var current *managedConn
+	defer func() {
+		if current != nil {
+			current.stop()
+		}
+	}()
+
+	for connectionCount := 0; ; {
+		if current == nil {
+			conn, err := smc.openManagedConn(ctx, slot, connectionCount, seen)
+			if err != nil {
+				return err
+			}
+			connectionCount++
+			current = conn
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-current.disconnect:
+			smc.logAt(slog.LevelInfo, []slog.Attr{slog.Int("slot", slot), slog.Int("connection_count", connectionCount)},
+				"Connection %d: disconnect requested, opening a replacement", slot)
+			smc.observe().Reconnecting(slack.ConnectionTypeSocketMode, slack.ReconnectReasonDisconnectRequested)
+
+			next, err := smc.openManagedConn(ctx, slot, connectionCount, seen)
+			if err != nil {
+				return err
+			}
+			connectionCount++
+			current.stop()
+			current = next
+
+		case <-current.done:
+			smc.logAt(slog.LevelWarn, []slog.Attr{
+				slog.Int("slot", slot),
+				slog.Int("connection_count", connectionCount),
+				slog.String("reason", reconnectReason(current.err)),
+			}, "Connection %d: reconnecting due to %v", slot, current.err)
+			smc.observe().Reconnecting(slack.ConnectionTypeSocketMode, reconnectReason(current.err))
+			current = nil
+		}
+	}
+}
+
+// managedConn is a connection served in the background.
+type managedConn struct {
+	cancel context.CancelFunc
+	// disconnect is closed when Slack requests the connection to be
+	// replaced.
+	disconnect chan struct{}
+	// done is closed once the connection is closed, err is then the reason.
+	done chan struct{}
+	err  error
+}
+
+func (smc *Client) openManagedConn(ctx context.Context, slot, connectionCount int, seen *envelopeSet) (*managedConn, error) {
+	pingChan, pingHandler := newPingHandler()
+	info, conn, err := smc.connect(ctx, connectionCount, pingHandler)
+	if err != nil {
+		smc.logAt(slog.LevelError, []slog.Attr{slog.Int("slot", slot), slog.Int("connection_count", connectionCount), slog.String("error", err.Error())},
+			"Connection %d: failed to connect with Socket Mode on try %d: %s", slot, connectionCount, err)
+		return nil, err
+	}
+
+	smc.sendEvent(ctx, newEvent(EventTypeConnected, &ConnectedEvent{
+		ConnectionCount: connectionCount,
+		Info:            info,
+		Slot:            slot,
+	}))
+
+	ctx, cancel := context.WithCancel(ctx)
+	mc := &managedConn{
+		cancel:     cancel,
+		disconnect: make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	var once sync.Once
+	go func() {
+		defer close(mc.done)
+		mc.err = smc.serve(ctx, conn, pingChan, func() error {
+			once.Do(func() { close(mc.disconnect) })
+			return nil
+		}, seen)
+	}()
+	return mc, nil
+}
+
+// stop closes the connection and waits for it to be released.
+func (mc *managedConn) stop() {
+	mc.cancel()
+	<-mc.done
+}
+
+// envelopeSet remembers recently received envelopes.
+type envelopeSet struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu     sync.Mutex
+	seen   map[string]time.Time
+	pruned time.Time
+}
+
+func newEnvelopeSet(window time.Duration) *envelopeSet {
+	return &envelopeSet{
+		window: window,
+		now:    time.Now,
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// add records req and reports whether it was not seen before. Slack retries
+// an unacknowledged envelope with a higher retry attempt, so retries are not
+// considered duplicates.
+func (s *envelopeSet) add(req *Request) bool {
+	if req == nil || req.EnvelopeID == "" {
+		return true
+	}
+	key := fmt.Sprintf("%s#%d", req.EnvelopeID, req.RetryAttempt)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if at, ok := s.seen[key]; ok && now.Sub(at) < s.window {
+		return false
+	}
+	if now.Sub(s.pruned) >= s.window {
+		maps.DeleteFunc(s.seen, func(_ string, at time.Time) bool { return now.Sub(at) >= s.window })
+		s.pruned = now
+	}
+	s.seen[key] = now
+	return true
+}
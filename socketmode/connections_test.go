@@ -0,0 +1,171 @@
+package socketmode
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/slack-go/slack"
+)
+
+// socketModeTestServer serves apps.connections.open and the WebSocket it
+// points to, handing every accepted connection to the test.
+type socketModeTestServer struct {
+	*httptest.Server
+	conns chan *websocket.Conn
+
+	mu     sync.Mutex
+	events []string
+}
+
+func newSocketModeTestServer(t *testing.T) *socketModeTestServer {
+	s := &socketModeTestServer{conns: make(chan *websocket.Conn, maxConnections)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/apps.connections.open", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true, "url": "ws` + strings.TrimPrefix(s.URL, "http") + `/link?ticket=secret-ticket"}`))
+	})
+	mux.HandleFunc("/link", func(rw http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+		conn, err := upgrader.Upgrade(rw, r, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		s.record("open")
+		conn.SetCloseHandler(func(code int, text string) error {
+			s.record("close")
+			return nil
+		})
+		go func() {
+			// Keep reading so that close frames are processed.
+			for {
+				var res Response
+				if err := conn.ReadJSON(&res); err != nil {
+					s.record("closed")
+					return
+				}
+				s.record("ack " + res.EnvelopeID)
+			}
+		}()
+		s.conns <- conn
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *socketModeTestServer) record(event string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+}
+
+func (s *socketModeTestServer) recorded() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.events...)
+}
+
+func (s *socketModeTestServer) accept(t *testing.T) *websocket.Conn {
+	select {
+	case conn := <-s.conns:
+		return conn
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a connection")
+		return nil
+	}
+}
+
+func startTestClient(t *testing.T, s *socketModeTestServer, options ...Option) (*Client, chan error) {
+	api := slack.New("xoxb-1", slack.OptionAppLevelToken("xapp-1"), slack.OptionAPIURL(s.URL+"/"))
+	client := New(api, options...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() { errc <- client.RunContext(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case <-errc:
+		default:
+		}
+	})
+	return client, errc
+}
+
+func nextEvent(t *testing.T, client *Client, types ...EventType) Event {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case evt := <-client.Events:
+			for _, tpe := range types {
+				if evt.Type == tpe {
+					return evt
+				}
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for %v", types)
+			return Event{}
+		}
+	}
+}
+
+func TestOptionConnections(t *testing.T) {
+	assert.Equal(t, 1, New(&slack.Client{}, OptionConnections(0)).connections)
+	assert.Equal(t, 4, New(&slack.Client{}, OptionConnections(4)).connections)
+	assert.Equal(t, maxConnections, New(&slack.Client{}, OptionConnections(50)).connections)
+}
+
+func TestConnectionsDedupeEnvelopes(t *testing.T) {
+	s := newSocketModeTestServer(t)
+	client, _ := startTestClient(t, s, OptionConnections(2))
+
+	first, second := s.accept(t), s.accept(t)
+	slots := map[int]bool{}
+	for range 2 {
+		evt := nextEvent(t, client, EventTypeConnected)
+		slots[evt.Data.(*ConnectedEvent).Slot] = true
+	}
+	assert.Equal(t, map[int]bool{0: true, 1: true}, slots)
+
+	require.NoError(t, first.WriteMessage(websocket.TextMessage, []byte(EventAppMention)))
+	require.NoError(t, second.WriteMessage(websocket.TextMessage, []byte(EventAppMention)))
+	retry := strings.Replace(EventAppMention, `"retry_attempt": 0`, `"retry_attempt": 1`, 1)
+	require.NoError(t, second.WriteMessage(websocket.TextMessage, []byte(retry)))
+
+	evt := nextEvent(t, client, EventTypeEventsAPI)
+	assert.Equal(t, 0, evt.Request.RetryAttempt)
+	evt = nextEvent(t, client, EventTypeEventsAPI)
+	assert.Equal(t, 1, evt.Request.RetryAttempt, "the duplicate is dropped, the retry is not")
+}
+
+func TestConnectionsHandoff(t *testing.T) {
+	s := newSocketModeTestServer(t)
+	client, _ := startTestClient(t, s, OptionConnections(1))
+
+	old := s.accept(t)
+	nextEvent(t, client, EventTypeConnected)
+
+	require.NoError(t, old.WriteMessage(websocket.TextMessage, []byte(EventDisconnect)))
+	replacement := s.accept(t)
+	evt := nextEvent(t, client, EventTypeConnected)
+	assert.Equal(t, 1, evt.Data.(*ConnectedEvent).ConnectionCount)
+
+	assert.Eventually(t, func() bool {
+		return len(s.recorded()) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"open", "open", "closed"}, s.recorded(), "the replacement is opened before the old connection is closed")
+
+	// The replacement delivers events.
+	require.NoError(t, replacement.WriteMessage(websocket.TextMessage, []byte(EventAppMention)))
+	nextEvent(t, client, EventTypeEventsAPI)
+}
@@ -1,6 +1,9 @@
 package socketmode
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+)
 
 // Event is the event sent to the consumer of Client
 type Event struct {
@@ -22,6 +25,9 @@ type ErrorWriteFailed struct {
 	Response *Response
 }
 
+// errPingTimeout is returned by serve when Slack stopped pinging.
+var errPingTimeout = errors.New("ping timeout: Slack did not send us WebSocket PING for more than Client.maxInterval")
+
 type errorRequestedDisconnect struct {
 }
 
@@ -0,0 +1,100 @@
+package socketmode
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/slack-go/slack"
+)
+
+// OptionLargeAckFallback makes SendCtx, Ack and AckCtx deliver payloads that
+// are too large for Socket Mode through the Web API instead of failing. The
+// envelope is acknowledged with an empty body first, then:
+//
+//   - a view submission answered with response_action "update" updates the
+//     submitted view with views.update, using its ID and hash;
+//   - a view submission answered with response_action "push" pushes the view
+//     with views.push, using the trigger_id of the submission, which expires
+//     3 seconds after it;
+//   - a slash command or an interaction carrying a response_url, such as a
+//     block action on a message, posts the payload to that URL with the HTTP
+//     client of the Web API client.
+//
+// Block actions in modals carry no response_url and are not covered: update
+// the modal with UpdateViewContext instead of acknowledging with a view.
+// Payloads of other envelopes still make SendCtx return an error.
+func OptionLargeAckFallback(enabled bool) Option {
+	return func(smc *Client) {
+		smc.largeAckFallback = enabled
+	}
+}
+
+// oversizedFallback returns a function delivering the payload of res through
+// the Web API, or nil if the fallback is disabled or the envelope it
+// acknowledges has no equivalent call.
+func (smc *Client) oversizedFallback(res Response) func(ctx context.Context) error {
+	if !smc.largeAckFallback {
+		return nil
+	}
+	req := smc.inflightRequest(res.EnvelopeID)
+	if req == nil || res.Payload == nil {
+		return nil
+	}
+	payload, err := json.Marshal(res.Payload)
+	if err != nil {
+		return nil
+	}
+
+	switch req.Type {
+	case RequestTypeSlashCommands:
+		var cmd slack.SlashCommand
+		if err := json.Unmarshal(req.Payload, &cmd); err != nil || cmd.ResponseURL == "" {
+			return nil
+		}
+		return smc.postToResponseURL(cmd.ResponseURL, payload)
+
+	case RequestTypeInteractive:
+		var callback slack.InteractionCallback
+		if err := json.Unmarshal(req.Payload, &callback); err != nil {
+			return nil
+		}
+		if callback.Type == slack.InteractionTypeViewSubmission {
+			return smc.viewSubmissionFallback(callback, payload)
+		}
+		if callback.ResponseURL != "" {
+			return smc.postToResponseURL(callback.ResponseURL, payload)
+		}
+	}
+	return nil
+}
+
+func (smc *Client) viewSubmissionFallback(callback slack.InteractionCallback, payload []byte) func(ctx context.Context) error {
+	var response slack.ViewSubmissionResponse
+	if err := json.Unmarshal(payload, &response); err != nil || response.View == nil {
+		return nil
+	}
+
+	switch response.ResponseAction {
+	case slack.RAUpdate:
+		return func(ctx context.Context) error {
+			_, err := smc.UpdateViewContext(ctx, *response.View, "", callback.View.Hash, callback.View.ID)
+			return err
+		}
+	case slack.RAPush:
+		return func(ctx context.Context) error {
+			_, err := smc.PushViewContext(ctx, callback.TriggerID, *response.View)
+			return err
+		}
+	}
+	return nil
+}
+
+func (smc *Client) postToResponseURL(responseURL string, payload []byte) func(ctx context.Context) error {
+	var msg slack.WebhookMessage
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		return nil
+	}
+	return func(ctx context.Context) error {
+		return smc.PostWebhookContext(ctx, responseURL, &msg)
+	}
+}
@@ -0,0 +1,145 @@
+package socketmode
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/slack-go/slack"
+)
+
+func newLargeAckClient(t *testing.T, handler http.HandlerFunc, req *Request, options ...slack.Option) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	api := slack.New("xoxb-1", append([]slack.Option{slack.OptionAPIURL(server.URL + "/")}, options...)...)
+	client := New(api, OptionLargeAckFallback(true))
+	require.True(t, client.trackEnvelope(req))
+	return client
+}
+
+// headerTransport sets a header on every request, to tell which HTTP client
+// made it.
+type headerTransport struct {
+	key, value string
+}
+
+func (tr headerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set(tr.key, tr.value)
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func largeText() string {
+	return strings.Repeat("x", maxResponseSize)
+}
+
+func assertEmptyAck(t *testing.T, client *Client, envelopeID string) {
+	t.Helper()
+	select {
+	case res := <-client.socketModeResponses:
+		assert.Equal(t, envelopeID, res.EnvelopeID)
+		assert.Nil(t, res.Payload)
+	default:
+		t.Fatal("expected an empty ack to be queued")
+	}
+}
+
+func TestLargeAckFallbackViewSubmission(t *testing.T) {
+	view := slack.ModalViewRequest{
+		Type:   slack.VTModal,
+		Blocks: slack.Blocks{BlockSet: []slack.Block{slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, largeText(), false, false), nil, nil)}},
+	}
+	for name, tt := range map[string]struct {
+		response *slack.ViewSubmissionResponse
+		path     string
+		want     map[string]any
+	}{
+		"update": {slack.NewUpdateViewSubmissionResponse(&view), "/views.update", map[string]any{"view_id": "V123", "hash": "H123"}},
+		"push":   {slack.NewPushViewSubmissionResponse(&view), "/views.push", map[string]any{"trigger_id": "T123"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var client *Client
+			var body map[string]any
+			var path string
+			var acked int
+			handler := func(rw http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				acked = len(client.socketModeResponses)
+				json.NewDecoder(r.Body).Decode(&body)
+				rw.Header().Set("Content-Type", "application/json")
+				rw.Write([]byte(`{"ok": true}`))
+			}
+			client = newLargeAckClient(t, handler, &Request{
+				Type:       RequestTypeInteractive,
+				EnvelopeID: "env-1",
+				Payload:    json.RawMessage(`{"type": "view_submission", "trigger_id": "T123", "view": {"id": "V123", "hash": "H123"}}`),
+			})
+
+			err := client.AckCtx(context.Background(), "env-1", tt.response)
+			require.NoError(t, err)
+
+			assert.Equal(t, 1, acked, "the envelope is acked before the view is sent")
+			assertEmptyAck(t, client, "env-1")
+			assert.Equal(t, tt.path, path)
+			for key, value := range tt.want {
+				assert.Equal(t, value, body[key], key)
+			}
+		})
+	}
+}
+
+func TestLargeAckFallbackModalBlockActions(t *testing.T) {
+	client := newLargeAckClient(t, nil, &Request{
+		Type:       RequestTypeInteractive,
+		EnvelopeID: "env-4",
+		Payload:    json.RawMessage(`{"type": "block_actions", "trigger_id": "T123", "view": {"id": "V123"}}`),
+	})
+
+	err := client.AckCtx(context.Background(), "env-4", map[string]any{"text": largeText()})
+	assert.ErrorContains(t, err, "would be silently dropped")
+	assert.Empty(t, client.socketModeResponses)
+}
+
+func TestLargeAckFallbackResponseURL(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "configured", r.Header.Get("X-Client"), "the response URL is posted with the configured HTTP client")
+		data, _ := io.ReadAll(r.Body)
+		received = string(data)
+	}))
+	defer server.Close()
+	responseURL := server.URL + "/commands/T1/1/abc"
+
+	client := newLargeAckClient(t, nil, &Request{
+		Type:       RequestTypeSlashCommands,
+		EnvelopeID: "env-2",
+		Payload:    json.RawMessage(`{"command": "/big", "is_enterprise_install": "false", "response_url": "` + responseURL + `"}`),
+	}, slack.OptionHTTPClient(&http.Client{Transport: headerTransport{"X-Client", "configured"}}))
+
+	err := client.AckCtx(context.Background(), "env-2", map[string]any{"text": largeText(), "response_type": "in_channel"})
+	require.NoError(t, err)
+
+	assertEmptyAck(t, client, "env-2")
+	assert.Contains(t, received, `"response_type":"in_channel"`)
+	assert.Contains(t, received, largeText())
+}
+
+func TestLargeAckFallbackUnsupported(t *testing.T) {
+	client := newLargeAckClient(t, nil, &Request{
+		Type:       RequestTypeEventsAPI,
+		EnvelopeID: "env-3",
+		Payload:    json.RawMessage(`{}`),
+	})
+
+	err := client.AckCtx(context.Background(), "env-3", map[string]any{"text": largeText()})
+	assert.ErrorContains(t, err, "would be silently dropped")
+	assert.Empty(t, client.socketModeResponses)
+}
@@ -1,6 +1,13 @@
 package socketmode
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/slack-go/slack/internal/redact"
+)
 
 // TODO merge logger, ilogger, and internalLogger with the top-level package's equivalents
 
@@ -39,13 +46,39 @@ func (t internalLog) Print(v ...any) {
 }
 
 func (smc *Client) Debugf(format string, v ...any) {
-	if smc.debug {
+	if smc.slog != nil {
+		smc.slog.Debug(redact.String(fmt.Sprintf(format, v...)))
+	} else if smc.debug {
 		smc.log.Output(2, fmt.Sprintf(format, v...))
 	}
 }
 
 func (smc *Client) Debugln(v ...any) {
-	if smc.debug {
+	if smc.slog != nil {
+		smc.slog.Debug(redact.String(strings.TrimSuffix(fmt.Sprintln(v...), "\n")))
+	} else if smc.debug {
 		smc.log.Output(2, fmt.Sprintln(v...))
 	}
 }
+
+// logAt formats a line as Debugf does. With a slog.Logger set, the line is
+// logged at level along with attrs instead.
+func (smc *Client) logAt(level slog.Level, attrs []slog.Attr, format string, v ...any) {
+	if smc.slog != nil {
+		smc.slog.LogAttrs(context.Background(), level, redact.String(fmt.Sprintf(format, v...)), attrs...)
+	} else if smc.debug {
+		smc.log.Output(3, fmt.Sprintf(format, v...))
+	}
+}
+
+// envelopeAttrs describes the envelope req for logging.
+func envelopeAttrs(req *Request) []slog.Attr {
+	if req == nil {
+		return nil
+	}
+	return []slog.Attr{
+		slog.String("envelope_id", req.EnvelopeID),
+		slog.String("event_type", req.Type),
+		slog.Int("retry_attempt", req.RetryAttempt),
+	}
+}
@@ -0,0 +1,54 @@
+package socketmode
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestOptionSlog(t *testing.T) {
+	buf := &syncBuffer{}
+	logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	s := newSocketModeTestServer(t)
+	client, _ := startTestClient(t, s, OptionSlog(logger))
+
+	conn := s.accept(t)
+	nextEvent(t, client, EventTypeConnected)
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(EventAppMention)))
+	evt := nextEvent(t, client, EventTypeEventsAPI)
+	client.Ack(*evt.Request)
+
+	assert.Eventually(t, func() bool {
+		return strings.Contains(buf.String(), "Finished sending")
+	}, 5*time.Second, 10*time.Millisecond)
+
+	logs := buf.String()
+	assert.Contains(t, logs, `level=INFO msg="WebSocket connection succeeded on try 0" connection_count=0`)
+	assert.Contains(t, logs, `envelope_id=`+evt.Request.EnvelopeID+` event_type=events_api retry_attempt=0`)
+	assert.Contains(t, logs, "/link?ticket=REDACTED")
+	assert.NotContains(t, logs, "secret-ticket")
+}
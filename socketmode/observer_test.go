@@ -0,0 +1,80 @@
+package socketmode
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/slack-go/slack"
+)
+
+type recordingObserver struct {
+	slack.NopConnectionObserver
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func (o *recordingObserver) record(call string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.calls = append(o.calls, call)
+}
+
+func (o *recordingObserver) recorded() []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]string(nil), o.calls...)
+}
+
+func (o *recordingObserver) ConnectAttempt(connection slack.ConnectionType, attempt int, err error) {
+	o.record(fmt.Sprintf("connect %s %d %v", connection, attempt, err))
+}
+
+func (o *recordingObserver) Reconnecting(connection slack.ConnectionType, reason string) {
+	o.record(fmt.Sprintf("reconnect %s", reason))
+}
+
+func (o *recordingObserver) EventReceived(connection slack.ConnectionType, eventType string) {
+	o.record("event " + eventType)
+}
+
+func (o *recordingObserver) Acked(connection slack.ConnectionType, eventType string, latency time.Duration) {
+	o.record("acked " + eventType)
+}
+
+func TestOptionObserver(t *testing.T) {
+	observer := &recordingObserver{}
+	s := newSocketModeTestServer(t)
+	client, _ := startTestClient(t, s, OptionObserver(observer))
+
+	conn := s.accept(t)
+	nextEvent(t, client, EventTypeConnected)
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(EventAppMention)))
+	evt := nextEvent(t, client, EventTypeEventsAPI)
+	client.Ack(*evt.Request)
+	assert.Eventually(t, func() bool {
+		return len(observer.recorded()) >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(EventDisconnect)))
+	s.accept(t)
+	assert.Eventually(t, func() bool {
+		return len(observer.recorded()) >= 6
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{
+		"connect socketmode 1 <nil>",
+		"event events_api",
+		"acked events_api",
+		"event disconnect",
+		"reconnect disconnect_requested",
+		"connect socketmode 1 <nil>",
+	}, observer.recorded()[:6])
+}
@@ -0,0 +1,218 @@
+package socketmode
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/slack-go/slack"
+)
+
+const (
+	// shutdownPollInterval is how often Shutdown checks whether in-flight
+	// envelopes have been acknowledged.
+	shutdownPollInterval = 20 * time.Millisecond
+	// inflightTTL is how long an envelope passed to Events is tracked until
+	// it is acknowledged. Slack retries envelopes that are not acknowledged
+	// within a few seconds, so later acknowledgements are of no use and
+	// envelopes the app never acknowledges must not be kept forever.
+	inflightTTL = 10 * time.Second
+)
+
+// OptionOnUnacked sets a callback invoked by Shutdown with the envelopes that
+// were passed to Events but not acknowledged before its context expired.
+// Slack will retry them, possibly against another instance of the app.
+func OptionOnUnacked(fn func(unacked []*Request)) Option {
+	return func(smc *Client) {
+		smc.onUnacked = fn
+	}
+}
+
+// runState tracks a running client for Shutdown.
+type runState struct {
+	mu           sync.Mutex
+	cancel       context.CancelFunc
+	shuttingDown bool
+	// inflight holds the envelopes passed to Events until they are acked,
+	// their acknowledgement cannot be written or inflightTTL has passed.
+	inflight map[string]inflightEnvelope
+	handlers int
+}
+
+type inflightEnvelope struct {
+	req      *Request
+	received time.Time
+}
+
+// Shutdown gracefully stops a client started with Run or RunContext. New
+// envelopes are no longer passed to Events, so that Slack retries them.
+// Shutdown then waits until the envelopes already passed on are
+// acknowledged, handlers started by SocketmodeHandler return and queued
+// responses are written, closes the connections and makes RunContext return
+// nil. Envelopes that are not acknowledged within 10 seconds of being
+// received are no longer waited for, as Slack retries them anyway. The client
+// can be run again after Shutdown.
+//
+// If ctx expires first, the envelopes left unacknowledged are reported to the
+// OptionOnUnacked callback, the connections are closed anyway and the context
+// error is returned.
+func (smc *Client) Shutdown(ctx context.Context) error {
+	smc.state.mu.Lock()
+	smc.state.shuttingDown = true
+	smc.state.mu.Unlock()
+
+	ticker := time.NewTicker(shutdownPollInterval)
+	defer ticker.Stop()
+
+	var err error
+wait:
+	for !smc.drained() {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			break wait
+		case <-ticker.C:
+		}
+	}
+
+	smc.state.mu.Lock()
+	cancel := smc.state.cancel
+	var unacked []*Request
+	for _, envelope := range smc.state.inflight {
+		unacked = append(unacked, envelope.req)
+	}
+	smc.state.mu.Unlock()
+
+	if len(unacked) > 0 && smc.onUnacked != nil {
+		slices.SortFunc(unacked, func(a, b *Request) int { return strings.Compare(a.EnvelopeID, b.EnvelopeID) })
+		smc.onUnacked(unacked)
+	}
+
+	if cancel != nil {
+		cancel()
+	}
+	return err
+}
+
+// drained reports whether nothing is left to acknowledge or send.
+func (smc *Client) drained() bool {
+	smc.state.mu.Lock()
+	defer smc.state.mu.Unlock()
+
+	smc.state.expireLocked(time.Now())
+	return len(smc.state.inflight) == 0 && smc.state.handlers == 0 && len(smc.socketModeResponses) == 0
+}
+
+// startRun returns the context RunContext runs with, which Shutdown cancels.
+// The state left by a previous run, including a completed Shutdown, is reset.
+func (smc *Client) startRun(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	smc.state.mu.Lock()
+	defer smc.state.mu.Unlock()
+	smc.state.cancel = cancel
+	smc.state.shuttingDown = false
+	clear(smc.state.inflight)
+	return ctx, cancel
+}
+
+// expireLocked forgets the envelopes received more than inflightTTL before
+// now. state.mu must be held.
+func (s *runState) expireLocked(now time.Time) {
+	for id, envelope := range s.inflight {
+		if now.Sub(envelope.received) > inflightTTL {
+			delete(s.inflight, id)
+		}
+	}
+}
+
+func (smc *Client) isShuttingDown() bool {
+	smc.state.mu.Lock()
+	defer smc.state.mu.Unlock()
+	return smc.state.shuttingDown
+}
+
+// trackEnvelope records req as passed to Events, and reports false when the
+// client is shutting down and req must be dropped instead.
+func (smc *Client) trackEnvelope(req *Request) bool {
+	if req == nil || req.EnvelopeID == "" {
+		return true
+	}
+
+	smc.state.mu.Lock()
+	defer smc.state.mu.Unlock()
+
+	if smc.state.shuttingDown {
+		return false
+	}
+	now := time.Now()
+	if smc.state.inflight == nil {
+		smc.state.inflight = make(map[string]inflightEnvelope)
+	}
+	smc.state.expireLocked(now)
+	smc.state.inflight[req.EnvelopeID] = inflightEnvelope{req: req, received: now}
+	return true
+}
+
+// inflightRequest returns the envelope passed to Events with envelopeID, if
+// it has not been acknowledged yet.
+func (smc *Client) inflightRequest(envelopeID string) *Request {
+	smc.state.mu.Lock()
+	defer smc.state.mu.Unlock()
+	return smc.state.inflight[envelopeID].req
+}
+
+// envelopeAcked records that the response for envelopeID was written.
+func (smc *Client) envelopeAcked(envelopeID string) {
+	smc.state.mu.Lock()
+	envelope, ok := smc.state.inflight[envelopeID]
+	delete(smc.state.inflight, envelopeID)
+	smc.state.mu.Unlock()
+
+	if ok {
+		smc.observe().Acked(slack.ConnectionTypeSocketMode, envelope.req.Type, time.Since(envelope.received))
+	}
+}
+
+// forgetEnvelope stops tracking envelopeID after its acknowledgement could
+// not be written. Slack retries the envelope.
+func (smc *Client) forgetEnvelope(envelopeID string) {
+	smc.state.mu.Lock()
+	defer smc.state.mu.Unlock()
+	delete(smc.state.inflight, envelopeID)
+}
+
+// runHandler calls f in a new goroutine that Shutdown waits for.
+func (smc *Client) runHandler(f SocketmodeHandlerFunc, evt *Event) {
+	smc.state.mu.Lock()
+	smc.state.handlers++
+	smc.state.mu.Unlock()
+
+	go func() {
+		start := time.Now()
+		defer func() {
+			smc.observe().HandlerDone(slack.ConnectionTypeSocketMode, string(evt.Type), time.Since(start))
+
+			smc.state.mu.Lock()
+			smc.state.handlers--
+			smc.state.mu.Unlock()
+		}()
+		f(evt, smc)
+	}()
+}
+
+// closeGracefully tells Slack the connection is going away when the client is
+// shutting down, then closes it.
+func (smc *Client) closeGracefully(conn *websocket.Conn) error {
+	if smc.isShuttingDown() {
+		msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+		if err := conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second)); err != nil {
+			smc.Debugf("Failed to send close message: %v", err)
+		}
+	}
+	return conn.Close()
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"sync"
 	"time"
@@ -44,6 +45,21 @@ func (smc *Client) Run() error {
 // If you want to retry even on reconnection failure, you'd need to write your own wrapper for this function
 // to do so.
 func (smc *Client) RunContext(ctx context.Context) error {
+	ctx, cancel := smc.startRun(ctx)
+	defer cancel()
+
+	err := smc.runUntilError(ctx)
+	if errors.Is(err, context.Canceled) && smc.isShuttingDown() {
+		return nil
+	}
+	return err
+}
+
+func (smc *Client) runUntilError(ctx context.Context) error {
+	if smc.connections > 0 {
+		return smc.runConnections(ctx)
+	}
+
 	for connectionCount := 0; ; connectionCount++ {
 		if err := smc.run(ctx, connectionCount); err != nil {
 			return err
@@ -54,17 +70,7 @@ func (smc *Client) RunContext(ctx context.Context) error {
 }
 
 func (smc *Client) run(ctx context.Context, connectionCount int) error {
-	messages := make(chan json.RawMessage, 1)
-
-	pingChan := make(chan time.Time, 1)
-	pingHandler := func(_ string) error {
-		select {
-		case pingChan <- time.Now():
-		default:
-		}
-
-		return nil
-	}
+	pingChan, pingHandler := newPingHandler()
 
 	// Start trying to connect
 	// the returned err is already passed onto the Events channel
@@ -75,20 +81,65 @@ func (smc *Client) run(ctx context.Context, connectionCount int) error {
 	info, conn, err := smc.connect(ctx, connectionCount, pingHandler)
 	if err != nil {
 		// when the connection is unsuccessful its fatal, and we need to bail out.
-		smc.Debugf("Failed to connect with Socket Mode on try %d: %s", connectionCount, err)
+		smc.logAt(slog.LevelError, []slog.Attr{slog.Int("connection_count", connectionCount), slog.String("error", err.Error())},
+			"Failed to connect with Socket Mode on try %d: %s", connectionCount, err)
 
 		return err
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
 	smc.sendEvent(ctx, newEvent(EventTypeConnected, &ConnectedEvent{
 		ConnectionCount: connectionCount,
 		Info:            info,
 	}))
 
-	smc.Debugf("WebSocket connection succeeded on try %d", connectionCount)
+	smc.logAt(slog.LevelInfo, []slog.Attr{slog.Int("connection_count", connectionCount)},
+		"WebSocket connection succeeded on try %d", connectionCount)
+
+	err = smc.serve(ctx, conn, pingChan, func() error {
+		// We treat the `disconnect` request from Slack as an error internally,
+		// so that we can tell the consumer of this function to reopen the connection on it.
+		return errorRequestedDisconnect{}
+	}, nil)
+
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	// serve finishes only after any of its go routines finishes and cancels the
+	// context, allowing the other threads to shut down gracefully.
+	// Also, we can expect our (first)err to be not nil, as goroutines can finish only on error.
+	smc.logAt(slog.LevelWarn, []slog.Attr{slog.Int("connection_count", connectionCount), slog.String("reason", reconnectReason(err))},
+		"Reconnecting due to %v", err)
+	smc.observe().Reconnecting(slack.ConnectionTypeSocketMode, reconnectReason(err))
+
+	return nil
+}
+
+// newPingHandler returns a ping handler for connect that records the time of
+// every WebSocket PING from Slack onto the returned channel.
+func newPingHandler() (chan time.Time, func(string) error) {
+	pingChan := make(chan time.Time, 1)
+	return pingChan, func(_ string) error {
+		select {
+		case pingChan <- time.Now():
+		default:
+		}
+
+		return nil
+	}
+}
+
+// serve reads Socket Mode requests from conn and writes queued responses to it
+// until the connection fails, Slack stops sending pings, onDisconnect returns
+// an error or ctx is cancelled. conn is closed when serve returns.
+//
+// onDisconnect is called when Slack sends a `disconnect` request. Envelopes
+// already in seen are dropped when it is not nil.
+func (smc *Client) serve(ctx context.Context, conn *websocket.Conn, pingChan chan time.Time, onDisconnect func() error, seen *envelopeSet) error {
+	messages := make(chan json.RawMessage, 1)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
 	// We're now connected so we can set up listeners
 
@@ -115,7 +166,7 @@ func (smc *Client) run(ctx context.Context, connectionCount int) error {
 		defer cancel()
 
 		// The handler reads Socket Mode requests, and enqueues responses for sending by the response sender
-		if err := smc.runRequestHandler(ctx, messages); err != nil {
+		if err := smc.runRequestHandler(ctx, messages, onDisconnect, seen); err != nil {
 			sendErr(err)
 		}
 	})
@@ -134,10 +185,21 @@ func (smc *Client) run(ctx context.Context, connectionCount int) error {
 	// reading the field from the goroutine below.
 	pingInterval := smc.maxPingInterval
 
+	// Slack pings us, so we only ping to measure latency for the observer.
+	measureLatency := smc.observer != nil
+	if measureLatency {
+		conn.SetPongHandler(func(appData string) error {
+			if sent, err := time.Parse(time.RFC3339Nano, appData); err == nil {
+				smc.observe().PingLatency(slack.ConnectionTypeSocketMode, time.Since(sent))
+			}
+			return nil
+		})
+	}
+
 	wg.Go(func() {
 		defer func() {
 			// Detect when the connection is dead and try close connection.
-			if err := conn.Close(); err != nil {
+			if err := smc.closeGracefully(conn); err != nil {
 				smc.Debugf("Failed to close connection: %v", err)
 			}
 		}()
@@ -163,11 +225,18 @@ func (smc *Client) run(ctx context.Context, connectionCount int) error {
 			case now := <-ticker.C:
 				// Our last ping is older than our interval
 				if now.Sub(lastPing) > pingInterval {
-					sendErr(errors.New("ping timeout: Slack did not send us WebSocket PING for more than Client.maxInterval"))
+					sendErr(errPingTimeout)
 
 					cancel()
 					return
 				}
+
+				if measureLatency {
+					payload := []byte(now.Format(time.RFC3339Nano))
+					if err := conn.WriteControl(websocket.PingMessage, payload, now.Add(10*time.Second)); err != nil {
+						smc.Debugf("Failed writing WebSocket PING message: %v", err)
+					}
+				}
 			}
 		}
 	})
@@ -175,22 +244,13 @@ func (smc *Client) run(ctx context.Context, connectionCount int) error {
 	wg.Wait()
 
 	select {
-	case err = <-errc:
+	case err := <-errc:
 		// Get buffered error
+		return err
 	default:
 		// Or nothing if they all exited nil
+		return nil
 	}
-
-	if errors.Is(err, context.Canceled) {
-		return err
-	}
-
-	// wg.Wait() finishes only after any of the above go routines finishes and cancels the
-	// context, allowing the other threads to shut down gracefully.
-	// Also, we can expect our (first)err to be not nil, as goroutines can finish only on error.
-	smc.Debugf("Reconnecting due to %v", err)
-
-	return nil
 }
 
 // connect attempts to connect to the slack websocket API. It handles any
@@ -223,6 +283,7 @@ func (smc *Client) connect(ctx context.Context, connectionCount int, additionalP
 
 		// attempt to start the connection
 		info, conn, err := smc.openAndDial(ctx, additionalPingHandler)
+		smc.observe().ConnectAttempt(slack.ConnectionTypeSocketMode, boff.Attempts()+1, err)
 		if err == nil {
 			return info, conn, nil
 		}
@@ -230,13 +291,19 @@ func (smc *Client) connect(ctx context.Context, connectionCount int, additionalP
 		// check for fatal errors
 		switch err.Error() {
 		case errInvalidAuth, errInactiveAccount, errMissingAuthToken, errTokenRevoked:
-			smc.Debugf("invalid auth when connecting with SocketMode: %s", err)
+			smc.logAt(slog.LevelError, []slog.Attr{slog.String("error", err.Error())}, "invalid auth when connecting with SocketMode: %s", err)
 			return nil, nil, err
 		default:
 		}
 
+		if _, ok := errors.AsType[slack.TokenTypeError](err); ok {
+			// retrying cannot help without an app-level token
+			smc.logAt(slog.LevelError, []slog.Attr{slog.String("error", err.Error())}, "unusable token when connecting with Socket Mode: %s", err)
+			return nil, nil, err
+		}
+
 		if codeErr, ok := errors.AsType[slack.StatusCodeError](err); ok && codeErr.Code == http.StatusNotFound {
-			smc.Debugf("invalid auth when connecting with Socket Mode: %s", err)
+			smc.logAt(slog.LevelError, []slog.Attr{slog.String("error", err.Error())}, "invalid auth when connecting with Socket Mode: %s", err)
 			smc.sendEvent(ctx, newEvent(EventTypeInvalidAuth, &slack.InvalidAuthEvent{}))
 
 			return nil, nil, err
@@ -258,7 +325,12 @@ func (smc *Client) connect(ctx context.Context, connectionCount int, additionalP
 		}))
 
 		// get time we should wait before attempting to connect again
-		smc.Debugf("reconnection %d failed: %s reconnecting in %v\n", boff.Attempts(), err, backoff)
+		smc.logAt(slog.LevelWarn, []slog.Attr{
+			slog.Int("connection_count", connectionCount),
+			slog.Int("attempt", boff.Attempts()),
+			slog.Duration("backoff", backoff),
+			slog.String("error", err.Error()),
+		}, "reconnection %d failed: %s reconnecting in %v", boff.Attempts(), err, backoff)
 
 		// wait for one of the following to occur,
 		// backoff duration has elapsed, disconnectCh is signalled, or
@@ -351,14 +423,19 @@ func (smc *Client) runResponseSender(ctx context.Context, conn *websocket.Conn)
 			smc.Debugf("Sending Socket Mode response with envelope ID %q: %v", res.EnvelopeID, res)
 
 			if err := unsafeWriteSocketModeResponse(conn, res); err != nil {
-				smc.Debugf("failed to write Socket Mode response for envelope ID %q: %v", res.EnvelopeID, err)
+				smc.logAt(slog.LevelError, []slog.Attr{slog.String("envelope_id", res.EnvelopeID), slog.String("error", err.Error())},
+					"failed to write Socket Mode response for envelope ID %q: %v", res.EnvelopeID, err)
+				smc.observe().ResponseDropped(slack.ConnectionTypeSocketMode, slack.DropReasonWriteFailed)
+				smc.forgetEnvelope(res.EnvelopeID)
 				smc.sendEvent(ctx, newEvent(EventTypeErrorWriteFailed, &ErrorWriteFailed{
 					Cause:    err,
 					Response: res,
 				}))
+			} else {
+				smc.envelopeAcked(res.EnvelopeID)
+				smc.logAt(slog.LevelDebug, []slog.Attr{slog.String("envelope_id", res.EnvelopeID)},
+					"Finished sending Socket Mode response with envelope ID %q", res.EnvelopeID)
 			}
-
-			smc.Debugf("Finished sending Socket Mode response with envelope ID %q", res.EnvelopeID)
 		}
 	}
 }
@@ -367,7 +444,7 @@ func (smc *Client) runResponseSender(ctx context.Context, conn *websocket.Conn)
 //
 // It reads WebSocket messages sent from Slack's Socket Mode WebSocket connection,
 // parses them as Socket Mode requests, and processes them and optionally emit our own events into Client.Events channel.
-func (smc *Client) runRequestHandler(ctx context.Context, websocket chan json.RawMessage) error {
+func (smc *Client) runRequestHandler(ctx context.Context, websocket chan json.RawMessage, onDisconnect func() error, seen *envelopeSet) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -389,12 +466,27 @@ func (smc *Client) runRequestHandler(ctx context.Context, websocket chan json.Ra
 					Message: message,
 				}))
 			} else if evt != nil {
+				smc.observe().EventReceived(slack.ConnectionTypeSocketMode, string(evt.Type))
+
 				if evt.Type == EventTypeDisconnect {
-					// We treat the `disconnect` request from Slack as an error internally,
-					// so that we can tell the consumer of this function to reopen the connection on it.
-					return errorRequestedDisconnect{}
+					if err := onDisconnect(); err != nil {
+						return err
+					}
+					continue
 				}
 
+				if seen != nil && !seen.add(evt.Request) {
+					smc.logAt(slog.LevelDebug, envelopeAttrs(evt.Request), "Dropping duplicate envelope %q", evt.Request.EnvelopeID)
+					continue
+				}
+
+				if !smc.trackEnvelope(evt.Request) {
+					// Slack retries the envelope once it is not acknowledged.
+					smc.logAt(slog.LevelInfo, envelopeAttrs(evt.Request), "Dropping envelope %q while shutting down", evt.Request.EnvelopeID)
+					continue
+				}
+
+				smc.logAt(slog.LevelDebug, envelopeAttrs(evt.Request), "Received %s envelope %q", evt.Type, evt.Request.EnvelopeID)
 				smc.sendEvent(ctx, *evt)
 			}
 		}
@@ -498,7 +590,8 @@ func (smc *Client) Send(res Response) error {
 // Slack's Socket Mode silently drops WebSocket responses that are 20KB or
 // larger (the write succeeds but Slack ignores the payload). SendCtx returns an
 // error if the serialized response reaches this limit. For large payloads, use
-// Web API methods instead (e.g. chat.PostMessage, views.Push).
+// Web API methods instead (e.g. chat.PostMessage, views.Push), or let
+// OptionLargeAckFallback do so.
 func (smc *Client) SendCtx(ctx context.Context, res Response) error {
 	js, err := json.Marshal(res)
 	if err != nil {
@@ -506,11 +599,25 @@ func (smc *Client) SendCtx(ctx context.Context, res Response) error {
 	}
 
 	if len(js) >= maxResponseSize {
+		if deliver := smc.oversizedFallback(res); deliver != nil {
+			smc.logAt(slog.LevelInfo, []slog.Attr{slog.String("envelope_id", res.EnvelopeID), slog.Int("size", len(js))},
+				"Socket Mode response for envelope ID %s is %d bytes, delivering it through the Web API", res.EnvelopeID, len(js))
+			if err := smc.SendCtx(ctx, Response{EnvelopeID: res.EnvelopeID}); err != nil {
+				return err
+			}
+			if err := deliver(ctx); err != nil {
+				return fmt.Errorf("delivering oversized socket mode response through the Web API: %w", err)
+			}
+			return nil
+		}
+		smc.observe().ResponseDropped(slack.ConnectionTypeSocketMode, slack.DropReasonOversized)
+		smc.logAt(slog.LevelError, []slog.Attr{slog.String("envelope_id", res.EnvelopeID), slog.Int("size", len(js))},
+			"Socket Mode response for envelope ID %s is %d bytes, dropping it", res.EnvelopeID, len(js))
 		return fmt.Errorf("socket mode response (%d bytes) meets or exceeds Slack's %d-byte WebSocket limit and would be silently dropped; use the Web API for large payloads",
 			len(js), maxResponseSize)
 	}
 
-	if smc.debug {
+	if smc.debug || smc.slog != nil {
 		smc.Debugf("Scheduling Socket Mode response for envelope ID %s: %s", res.EnvelopeID, js)
 	}
 
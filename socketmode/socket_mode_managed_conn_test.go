@@ -19,7 +19,7 @@ func Test_passContext(t *testing.T) {
 	s := slacktest.NewTestServer()
 	go s.Start()
 
-	api := slack.New("ABCDEFG", slack.OptionAPIURL(s.GetAPIURL()))
+	api := slack.New("ABCDEFG", slack.OptionAPIURL(s.GetAPIURL()), slack.OptionAppLevelToken("xapp-1-ABCDEFG"))
 	cli := New(api)
 
 	ctx, cancel := context.WithTimeout(context.TODO(), time.Nanosecond)
@@ -45,6 +45,22 @@ func Test_passContext(t *testing.T) {
 	})
 }
 
+func TestRunContext_WithoutAppLevelToken(t *testing.T) {
+	api := slack.New("xoxb-ABCDEFG", slack.OptionAPIURL("http://127.0.0.1:0/"))
+	cli := New(api)
+	go func() {
+		for range cli.Events {
+		}
+	}()
+
+	err := cli.RunContext(context.Background())
+
+	tokenErr, ok := errors.AsType[slack.TokenTypeError](err)
+	assert.True(t, ok, "expected a TokenTypeError, got %v", err)
+	assert.True(t, tokenErr.Missing)
+	assert.Equal(t, slack.TokenTypeApp, tokenErr.Want)
+}
+
 func TestSendCtx_PayloadQueued(t *testing.T) {
 	api := slack.New("ABCDEFG")
 	cli := New(api)
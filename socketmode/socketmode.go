@@ -2,7 +2,9 @@ package socketmode
 
 import (
 	"context"
+	"errors"
 	"log"
+	"log/slog"
 	"os"
 	"time"
 
@@ -112,6 +114,27 @@ func OptionPingInterval(d time.Duration) Option {
 	}
 }
 
+// OptionObserver reports connection attempts, reconnections, ping latency,
+// envelopes, ack latency, handler durations and dropped responses to o.
+//
+// With an observer set, the client also pings Slack every ping interval to
+// measure latency.
+func OptionObserver(o slack.ConnectionObserver) Option {
+	return func(smc *Client) {
+		smc.observer = o
+	}
+}
+
+// OptionSlog sets a structured logger. Connections, reconnections, envelopes
+// and responses are logged with attributes such as the envelope ID, event
+// type and connection count, at a level matching their severity. Debug output
+// is sent to it at debug level regardless of OptionDebug.
+func OptionSlog(l *slog.Logger) Option {
+	return func(smc *Client) {
+		smc.slog = l
+	}
+}
+
 // OptionDebug enable debugging for the client
 func OptionDebug(b bool) func(*Client) {
 	return func(c *Client) {
@@ -144,6 +167,28 @@ func New(api *slack.Client, options ...Option) *Client {
 	return result
 }
 
+// observe returns the observer set with OptionObserver, or one that ignores
+// everything.
+func (smc *Client) observe() slack.ConnectionObserver {
+	if smc.observer == nil {
+		return slack.NopConnectionObserver{}
+	}
+	return smc.observer
+}
+
+// reconnectReason maps the error a connection ended with to a
+// ConnectionObserver reconnect reason.
+func reconnectReason(err error) string {
+	switch {
+	case errors.Is(err, errorRequestedDisconnect{}):
+		return slack.ReconnectReasonDisconnectRequested
+	case errors.Is(err, errPingTimeout):
+		return slack.ReconnectReasonPingTimeout
+	default:
+		return slack.ReconnectReasonConnectionError
+	}
+}
+
 // sendEvent safely sends an event into the Clients Events channel
 // and blocks until buffer space is had, or the context is canceled.
 // This prevents deadlocking in the event that Events buffer is full,
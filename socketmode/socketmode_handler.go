@@ -213,7 +213,7 @@ func (r *SocketmodeHandler) DispatchEvent(evt Event) {
 	}
 
 	if !ishandled {
-		go r.Default(&evt, r.Client)
+		r.Client.runHandler(r.Default, &evt)
 	}
 }
 
@@ -226,7 +226,7 @@ func (r *SocketmodeHandler) socketmodeDispatcher(evt *Event) bool {
 	if handlers, ok := r.EventMap[evt.Type]; ok {
 		// If we registered an event
 		for _, f := range handlers {
-			go f(evt, r.Client)
+			r.Client.runHandler(f, evt)
 		}
 
 		return true
@@ -252,7 +252,7 @@ func (r *SocketmodeHandler) interactionDispatcher(evt *Event) bool {
 	if handlers, ok := r.InteractionEventMap[interaction.Type]; ok {
 		// If we registered an event
 		for _, f := range handlers {
-			go f(evt, r.Client)
+			r.Client.runHandler(f, evt)
 		}
 
 		ishandled = true
@@ -267,23 +267,23 @@ func (r *SocketmodeHandler) interactionDispatcher(evt *Event) bool {
 
 		for _, action := range blockActions {
 			if handler, ok := r.InteractionBlockActionEventMap[action.ActionID]; ok {
-				go handler(evt, r.Client)
+				r.Client.runHandler(handler, evt)
 				ishandled = true
 			}
 		}
 	case slack.InteractionTypeShortcut, slack.InteractionTypeMessageAction:
 		if handler, ok := r.InteractionShortcutEventMap[interaction.CallbackID]; ok {
-			go handler(evt, r.Client)
+			r.Client.runHandler(handler, evt)
 			ishandled = true
 		}
 	case slack.InteractionTypeViewSubmission:
 		if handler, ok := r.InteractionViewSubmissionEventMap[interaction.View.CallbackID]; ok {
-			go handler(evt, r.Client)
+			r.Client.runHandler(handler, evt)
 			ishandled = true
 		}
 	case slack.InteractionTypeViewClosed:
 		if handler, ok := r.InteractionViewClosedEventMap[interaction.View.CallbackID]; ok {
-			go handler(evt, r.Client)
+			r.Client.runHandler(handler, evt)
 			ishandled = true
 		}
 	}
@@ -309,7 +309,7 @@ func (r *SocketmodeHandler) eventAPIDispatcher(evt *Event) bool {
 	if handlers, ok := r.EventApiMap[innerEventType]; ok {
 		// If we registered an event
 		for _, f := range handlers {
-			go f(evt, r.Client)
+			r.Client.runHandler(f, evt)
 		}
 
 		ishandled = true
@@ -333,7 +333,7 @@ func (r *SocketmodeHandler) slashCommandDispatcher(evt *Event) bool {
 	// Level 2 - SlackCommand by name
 	if handler, ok := r.SlashCommandMap[slashCommandEvent.Command]; ok {
 
-		go handler(evt, r.Client)
+		r.Client.runHandler(handler, evt)
 
 		ishandled = true
 	}
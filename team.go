@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 type TeamResponse struct {
@@ -244,3 +245,215 @@ func (api *Client) GetBillableInfoContext(ctx context.Context, params GetBillabl
 
 	return api.billableInfoRequest(ctx, "team.billableInfo", values)
 }
+
+// IntegrationLogsParameters contains all the parameters necessary (including the optional ones) for a GetIntegrationLogs() request
+type IntegrationLogsParameters struct {
+	AppID      string
+	ServiceID  string
+	ChangeType string
+	User       string
+	TeamID     string
+	Count      int
+	Page       int
+}
+
+type IntegrationLogsResponse struct {
+	Logs   []IntegrationLog `json:"logs"`
+	Paging `json:"paging"`
+	SlackResponse
+}
+
+// IntegrationLog is an app or custom integration being added, removed, enabled, disabled or expanded.
+type IntegrationLog struct {
+	ServiceID   string `json:"service_id"`
+	ServiceType string `json:"service_type"`
+	AppID       string `json:"app_id"`
+	AppType     string `json:"app_type"`
+	UserID      string `json:"user_id"`
+	UserName    string `json:"user_name"`
+	Channel     string `json:"channel"`
+	Date        string `json:"date"`
+	ChangeType  string `json:"change_type"`
+	Reason      string `json:"reason"`
+	Scope       string `json:"scope"`
+}
+
+// GetIntegrationLogs retrieves a page of integration logs according to the parameters given.
+// For more information see the GetIntegrationLogsContext documentation.
+func (api *Client) GetIntegrationLogs(params IntegrationLogsParameters) ([]IntegrationLog, *Paging, error) {
+	return api.GetIntegrationLogsContext(context.Background(), params)
+}
+
+// GetIntegrationLogsContext retrieves a page of integration logs according to the parameters given with a custom context.
+// Slack API docs: https://api.slack.com/methods/team.integrationLogs
+func (api *Client) GetIntegrationLogsContext(ctx context.Context, params IntegrationLogsParameters) ([]IntegrationLog, *Paging, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+	if params.AppID != "" {
+		values.Add("app_id", params.AppID)
+	}
+	if params.ServiceID != "" {
+		values.Add("service_id", params.ServiceID)
+	}
+	if params.ChangeType != "" {
+		values.Add("change_type", params.ChangeType)
+	}
+	if params.User != "" {
+		values.Add("user", params.User)
+	}
+	if params.TeamID != "" {
+		values.Add("team_id", params.TeamID)
+	}
+	if params.Count != 0 {
+		values.Add("count", strconv.Itoa(params.Count))
+	}
+	if params.Page != 0 {
+		values.Add("page", strconv.Itoa(params.Page))
+	}
+
+	response := &IntegrationLogsResponse{}
+	err := api.postMethod(ctx, "team.integrationLogs", values, response)
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Logs, &response.Paging, response.Err()
+}
+
+type TeamPreferencesResponse struct {
+	TeamPreferences
+	SlackResponse
+}
+
+// TeamPreferences are the workspace preferences readable by apps.
+type TeamPreferences struct {
+	MsgEditWindowMins    int    `json:"msg_edit_window_mins"`
+	AllowMessageDeletion bool   `json:"allow_message_deletion"`
+	DisplayRealNames     bool   `json:"display_real_names"`
+	DisableFileUploads   string `json:"disable_file_uploads"`
+	WhoCanPostGeneral    string `json:"who_can_post_general"`
+}
+
+// GetTeamPreferences retrieves the list of the workspace's preferences.
+// For more information see the GetTeamPreferencesContext documentation.
+func (api *Client) GetTeamPreferences() (*TeamPreferences, error) {
+	return api.GetTeamPreferencesContext(context.Background())
+}
+
+// GetTeamPreferencesContext retrieves the list of the workspace's preferences with a custom context.
+// Slack API docs: https://api.slack.com/methods/team.preferences.list
+func (api *Client) GetTeamPreferencesContext(ctx context.Context) (*TeamPreferences, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+
+	response := &TeamPreferencesResponse{}
+	err := api.postMethod(ctx, "team.preferences.list", values, response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.TeamPreferences, response.Err()
+}
+
+// ExternalTeamsParameters contains all the parameters necessary (including the optional ones) for a GetExternalTeams() request
+type ExternalTeamsParameters struct {
+	ConnectionStatusFilter string
+	SlackConnectPrefFilter []string
+	WorkspaceFilter        []string
+	SortField              string
+	SortDirection          string
+	Cursor                 string
+	Limit                  int
+}
+
+type ExternalTeamsResponse struct {
+	Organizations []ExternalTeam `json:"organizations"`
+	TotalCount    int            `json:"total_count"`
+	SlackResponse
+}
+
+// ExternalTeam is an external organization connected to the workspace through Slack Connect.
+type ExternalTeam struct {
+	TeamID              string                  `json:"team_id"`
+	TeamName            string                  `json:"team_name"`
+	TeamDomain          string                  `json:"team_domain"`
+	ConnectionStatus    string                  `json:"connection_status"`
+	IsSponsored         bool                    `json:"is_sponsored"`
+	LastActiveTimestamp int64                   `json:"last_active_timestamp"`
+	PublicChannelCount  int                     `json:"public_channel_count"`
+	PrivateChannelCount int                     `json:"private_channel_count"`
+	IMChannelCount      int                     `json:"im_channel_count"`
+	MPIMChannelCount    int                     `json:"mpim_channel_count"`
+	ConnectedWorkspaces []ExternalTeamWorkspace `json:"connected_workspaces"`
+	SlackConnectPrefs   map[string]any          `json:"slack_connect_prefs,omitempty"`
+}
+
+// ExternalTeamWorkspace is a workspace of ours connected to an external organization.
+type ExternalTeamWorkspace struct {
+	WorkspaceID   string `json:"workspace_id"`
+	WorkspaceName string `json:"workspace_name"`
+}
+
+// GetExternalTeams retrieves a page of external organizations according to the parameters given.
+// For more information see the GetExternalTeamsContext documentation.
+func (api *Client) GetExternalTeams(params ExternalTeamsParameters) ([]ExternalTeam, string, error) {
+	return api.GetExternalTeamsContext(context.Background(), params)
+}
+
+// GetExternalTeamsContext retrieves a page of external organizations according to the parameters given with a custom context.
+// Slack API docs: https://api.slack.com/methods/team.externalTeams.list
+func (api *Client) GetExternalTeamsContext(ctx context.Context, params ExternalTeamsParameters) ([]ExternalTeam, string, error) {
+	values := url.Values{
+		"token": {api.token},
+	}
+	if params.ConnectionStatusFilter != "" {
+		values.Add("connection_status_filter", params.ConnectionStatusFilter)
+	}
+	if len(params.SlackConnectPrefFilter) > 0 {
+		values.Add("slack_connect_pref_filter", strings.Join(params.SlackConnectPrefFilter, ","))
+	}
+	if len(params.WorkspaceFilter) > 0 {
+		values.Add("workspace_filter", strings.Join(params.WorkspaceFilter, ","))
+	}
+	if params.SortField != "" {
+		values.Add("sort_field", params.SortField)
+	}
+	if params.SortDirection != "" {
+		values.Add("sort_direction", params.SortDirection)
+	}
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+	if params.Limit != 0 {
+		values.Add("limit", strconv.Itoa(params.Limit))
+	}
+
+	response := &ExternalTeamsResponse{}
+	err := api.postMethod(ctx, "team.externalTeams.list", values, response)
+	if err != nil {
+		return nil, "", err
+	}
+	return response.Organizations, response.ResponseMetadata.Cursor, response.Err()
+}
+
+// DisconnectExternalTeam disconnects an external organization from the workspace.
+// For more information see the DisconnectExternalTeamContext documentation.
+func (api *Client) DisconnectExternalTeam(targetTeam string) error {
+	return api.DisconnectExternalTeamContext(context.Background(), targetTeam)
+}
+
+// DisconnectExternalTeamContext disconnects an external organization from the workspace with a custom context.
+// Slack API docs: https://api.slack.com/methods/team.externalTeams.disconnect
+func (api *Client) DisconnectExternalTeamContext(ctx context.Context, targetTeam string) error {
+	values := url.Values{
+		"token":       {api.token},
+		"target_team": {targetTeam},
+	}
+
+	response := &SlackResponse{}
+	err := api.postMethod(ctx, "team.externalTeams.disconnect", values, response)
+	if err != nil {
+		return err
+	}
+	return response.Err()
+}
@@ -227,3 +227,168 @@ func TestGetAccessLogs(t *testing.T) {
 		t.Fatalf("Expected cursor %q, got %q", "dGVhbV9pZDo5MDAwMTcw", nextCursor)
 	}
 }
+
+func getTeamIntegrationLogs(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	if r.FormValue("change_type") != "added" || r.FormValue("page") != "2" {
+		rw.Write([]byte(`{"ok": false, "error": "invalid_arguments"}`))
+		return
+	}
+	response := []byte(`{"ok": true, "logs": [
+			{
+				"service_id": "1234567890",
+				"service_type": "Google Calendar",
+				"user_id": "U1234ABCD",
+				"user_name": "Johnny",
+				"channel": "C1234567890",
+				"date": "1392163200",
+				"change_type": "added",
+				"scope": "incoming-webhook"
+			},
+			{
+				"app_id": "2345678901",
+				"app_type": "Johnny App",
+				"user_id": "U2345BCDE",
+				"user_name": "Billy",
+				"date": "1392163201",
+				"change_type": "added",
+				"scope": "chat:write:user,channels:read"
+			}
+		],
+		"paging": {"count": 2, "total": 5, "page": 2, "pages": 3}
+	}`)
+	rw.Write(response)
+}
+
+func TestGetIntegrationLogs(t *testing.T) {
+	http.HandleFunc("/team.integrationLogs", getTeamIntegrationLogs)
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	logs, paging, err := api.GetIntegrationLogs(IntegrationLogsParameters{ChangeType: "added", Count: 2, Page: 2})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	if len(logs) != 2 {
+		t.Fatal("Should have been 2 logs")
+	}
+	if logs[0].ServiceType != "Google Calendar" || logs[0].Channel != "C1234567890" {
+		t.Fatal(ErrIncorrectResponse)
+	}
+	if logs[1].AppID != "2345678901" || logs[1].Scope != "chat:write:user,channels:read" {
+		t.Fatal(ErrIncorrectResponse)
+	}
+	if *paging != (Paging{Count: 2, Total: 5, Page: 2, Pages: 3}) {
+		t.Fatalf("Unexpected paging %#v", paging)
+	}
+}
+
+func getTeamPreferences(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	response := []byte(`{
+		"ok": true,
+		"msg_edit_window_mins": 25,
+		"allow_message_deletion": true,
+		"display_real_names": false,
+		"disable_file_uploads": "allow_all",
+		"who_can_post_general": "everyone"
+	}`)
+	rw.Write(response)
+}
+
+func TestGetTeamPreferences(t *testing.T) {
+	http.HandleFunc("/team.preferences.list", getTeamPreferences)
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	prefs, err := api.GetTeamPreferences()
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	expected := TeamPreferences{
+		MsgEditWindowMins:    25,
+		AllowMessageDeletion: true,
+		DisableFileUploads:   "allow_all",
+		WhoCanPostGeneral:    "everyone",
+	}
+	if *prefs != expected {
+		t.Fatalf("Expected %#v, got %#v", expected, *prefs)
+	}
+}
+
+func getTeamExternalTeams(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	if r.FormValue("workspace_filter") != "T1,T2" {
+		rw.Write([]byte(`{"ok": false, "error": "invalid_arguments"}`))
+		return
+	}
+	response := []byte(`{
+		"ok": true,
+		"organizations": [{
+			"team_id": "E0123",
+			"team_name": "Partner Corp",
+			"team_domain": "partner",
+			"connection_status": "connected",
+			"public_channel_count": 3,
+			"private_channel_count": 1,
+			"connected_workspaces": [{"workspace_id": "T1", "workspace_name": "Engineering"}],
+			"last_active_timestamp": 1700000000
+		}],
+		"total_count": 1,
+		"response_metadata": {"next_cursor": "abc"}
+	}`)
+	rw.Write(response)
+}
+
+func TestGetExternalTeams(t *testing.T) {
+	http.HandleFunc("/team.externalTeams.list", getTeamExternalTeams)
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	teams, nextCursor, err := api.GetExternalTeams(ExternalTeamsParameters{WorkspaceFilter: []string{"T1", "T2"}})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	if len(teams) != 1 {
+		t.Fatal("Should have been 1 organization")
+	}
+	if teams[0].TeamName != "Partner Corp" || teams[0].PublicChannelCount != 3 {
+		t.Fatal(ErrIncorrectResponse)
+	}
+	if len(teams[0].ConnectedWorkspaces) != 1 || teams[0].ConnectedWorkspaces[0].WorkspaceName != "Engineering" {
+		t.Fatal(ErrIncorrectResponse)
+	}
+	if nextCursor != "abc" {
+		t.Fatalf("Expected cursor %q, got %q", "abc", nextCursor)
+	}
+}
+
+func TestDisconnectExternalTeam(t *testing.T) {
+	http.HandleFunc("/team.externalTeams.disconnect", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("target_team") != "E0123" {
+			rw.Write([]byte(`{"ok": false, "error": "team_not_found"}`))
+			return
+		}
+		rw.Write([]byte(`{"ok": true}`))
+	})
+
+	once.Do(startServer)
+	api := New("testing-token", OptionAPIURL("http://"+serverAddr+"/"))
+
+	if err := api.DisconnectExternalTeam("E0123"); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+	if err := api.DisconnectExternalTeam("E9999"); err == nil || err.Error() != "team_not_found" {
+		t.Errorf("Expected team_not_found, got %v", err)
+	}
+}
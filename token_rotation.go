@@ -0,0 +1,148 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultTokenRefreshMargin is how long before expiry a rotating token is
+// refreshed when TokenRotationConfig.RefreshMargin is not set.
+const DefaultTokenRefreshMargin = 5 * time.Minute
+
+// RotatedToken is a token pair issued by oauth.v2.access with the
+// refresh_token grant.
+type RotatedToken struct {
+	AccessToken  string
+	RefreshToken string
+	// ExpiresAt is zero when Slack did not return an expiry.
+	ExpiresAt time.Time
+}
+
+// TokenRotationConfig configures automatic refresh of the Client token for
+// apps with token rotation enabled. The access token is the one passed to
+// New.
+type TokenRotationConfig struct {
+	ClientID string
+	// ClientSecret may be empty for public clients using PKCE.
+	ClientSecret string
+	RefreshToken string
+	// ExpiresAt is when the access token expires. When zero the token is
+	// not refreshed ahead of time, only once Slack reports token_expired.
+	ExpiresAt time.Time
+	// RefreshMargin defaults to DefaultTokenRefreshMargin.
+	RefreshMargin time.Duration
+	// OnRefresh is called with every new token pair so that it can be
+	// persisted. The previous refresh token is no longer valid at this point.
+	OnRefresh func(ctx context.Context, token RotatedToken)
+	// OAuthOptions are passed to RefreshOAuthV2TokenContext. The Client API
+	// URL is used unless overridden here.
+	OAuthOptions []OAuthOption
+}
+
+// OptionTokenRotation makes the client refresh its token before it expires
+// and retry a call once when Slack reports token_expired.
+//
+//	api := slack.New(accessToken, slack.OptionTokenRotation(slack.TokenRotationConfig{
+//		ClientID:     clientID,
+//		ClientSecret: clientSecret,
+//		RefreshToken: refreshToken,
+//		ExpiresAt:    expiresAt,
+//		OnRefresh: func(ctx context.Context, t slack.RotatedToken) {
+//			db.SaveTokens(t.AccessToken, t.RefreshToken, t.ExpiresAt)
+//		},
+//	}))
+func OptionTokenRotation(config TokenRotationConfig) func(*Client) {
+	return func(c *Client) {
+		if config.RefreshMargin <= 0 {
+			config.RefreshMargin = DefaultTokenRefreshMargin
+		}
+		c.tokenRotator = &tokenRotator{
+			config: config,
+			api:    c,
+			token: RotatedToken{
+				AccessToken:  c.token,
+				RefreshToken: config.RefreshToken,
+				ExpiresAt:    config.ExpiresAt,
+			},
+			now: time.Now,
+		}
+	}
+}
+
+type tokenRotator struct {
+	config TokenRotationConfig
+	api    *Client
+	now    func() time.Time
+
+	mu    sync.Mutex
+	token RotatedToken
+}
+
+// accessToken returns a token valid for at least the refresh margin,
+// refreshing it first if needed. A token without an expiry is never
+// refreshed here. A failed refresh is only reported once the current token
+// has expired.
+func (r *tokenRotator) accessToken(ctx context.Context) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if r.token.ExpiresAt.IsZero() || now.Add(r.config.RefreshMargin).Before(r.token.ExpiresAt) {
+		return r.token.AccessToken, nil
+	}
+
+	if err := r.refreshLocked(ctx); err != nil {
+		if now.Before(r.token.ExpiresAt) {
+			r.api.Debugf("token refresh failed, using current token until it expires: %v", err)
+			return r.token.AccessToken, nil
+		}
+		return "", err
+	}
+	return r.token.AccessToken, nil
+}
+
+// refreshExpired refreshes the token after Slack rejected stale as expired,
+// unless a concurrent call already replaced it.
+func (r *tokenRotator) refreshExpired(ctx context.Context, stale string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.token.AccessToken != stale {
+		return r.token.AccessToken, nil
+	}
+	if err := r.refreshLocked(ctx); err != nil {
+		return "", err
+	}
+	return r.token.AccessToken, nil
+}
+
+func (r *tokenRotator) refreshLocked(ctx context.Context) error {
+	opts := append([]OAuthOption{OAuthOptionAPIURL(r.api.endpoint)}, r.config.OAuthOptions...)
+	resp, err := RefreshOAuthV2TokenContext(ctx, r.api.httpclient, r.config.ClientID, r.config.ClientSecret, r.token.RefreshToken, opts...)
+	if err != nil {
+		return err
+	}
+
+	r.token = RotatedToken{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+	}
+	if resp.ExpiresIn > 0 {
+		r.token.ExpiresAt = r.now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	}
+	if r.config.OnRefresh != nil {
+		r.config.OnRefresh(ctx, r.token)
+	}
+	return nil
+}
+
+func isTokenExpired(intf any) bool {
+	r, ok := intf.(interface{ Err() error })
+	if !ok {
+		return false
+	}
+	var slackErr SlackErrorResponse
+	return errors.As(r.Err(), &slackErr) && slackErr.Err == "token_expired"
+}
@@ -0,0 +1,218 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTokenRotationServer serves oauth.v2.access, handing out xoxe.xoxb-N
+// tokens expiring in expiresIn seconds (none when zero), and auth.test,
+// accepting only the latest token.
+func newTokenRotationServer(t *testing.T, expiresIn int) (*httptest.Server, *atomic.Int32) {
+	var refreshes atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth.v2.access", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "refresh_token", r.FormValue("grant_type"))
+		assert.Equal(t, "client-id", r.FormValue("client_id"))
+		n := refreshes.Add(1)
+		assert.Equal(t, refreshTokenN(n-1), r.FormValue("refresh_token"))
+		rw.Header().Set("Content-Type", "application/json")
+		expiry := ""
+		if expiresIn > 0 {
+			expiry = `, "expires_in": ` + strconv.Itoa(expiresIn)
+		}
+		rw.Write([]byte(`{"ok": true, "token_type": "bot", "access_token": "` + accessTokenN(n) + `", "refresh_token": "` + refreshTokenN(n) + `"` + expiry + `}`))
+	})
+	mux.HandleFunc("/auth.test", func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if r.FormValue("token") != accessTokenN(refreshes.Load()) {
+			rw.Write([]byte(`{"ok": false, "error": "token_expired"}`))
+			return
+		}
+		rw.Write([]byte(`{"ok": true, "team_id": "T1"}`))
+	})
+	// Other calls also accept only the latest token, sent as a bearer token
+	// or a form value.
+	mux.HandleFunc("/", func(rw http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" {
+			token = r.FormValue("token")
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		if token != accessTokenN(refreshes.Load()) {
+			rw.Write([]byte(`{"ok": false, "error": "token_expired"}`))
+			return
+		}
+		rw.Write([]byte(`{"ok": true}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &refreshes
+}
+
+func accessTokenN(n int32) string  { return "xoxe.xoxb-" + string(rune('0'+n)) }
+func refreshTokenN(n int32) string { return "xoxe-1-" + string(rune('0'+n)) }
+
+func TestTokenRotationRefreshesBeforeExpiry(t *testing.T) {
+	server, refreshes := newTokenRotationServer(t, 43200)
+
+	var persisted []RotatedToken
+	api := New(accessTokenN(0),
+		OptionAPIURL(server.URL+"/"),
+		OptionTokenRotation(TokenRotationConfig{
+			ClientID:     "client-id",
+			RefreshToken: refreshTokenN(0),
+			ExpiresAt:    time.Now().Add(time.Minute),
+			OnRefresh: func(ctx context.Context, token RotatedToken) {
+				persisted = append(persisted, token)
+			},
+		}),
+	)
+
+	for range 3 {
+		_, err := api.AuthTest()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), refreshes.Load())
+	require.Len(t, persisted, 1)
+	assert.Equal(t, accessTokenN(1), persisted[0].AccessToken)
+	assert.Equal(t, refreshTokenN(1), persisted[0].RefreshToken)
+	assert.WithinDuration(t, time.Now().Add(12*time.Hour), persisted[0].ExpiresAt, time.Minute)
+}
+
+func TestTokenRotationWithoutExpiry(t *testing.T) {
+	server, refreshes := newTokenRotationServer(t, 0)
+
+	var persisted []RotatedToken
+	api := New(accessTokenN(0),
+		OptionAPIURL(server.URL+"/"),
+		OptionTokenRotation(TokenRotationConfig{
+			ClientID:     "client-id",
+			RefreshToken: refreshTokenN(1),
+			OnRefresh: func(ctx context.Context, token RotatedToken) {
+				persisted = append(persisted, token)
+			},
+		}),
+	)
+
+	// Without an expiry the token is used as is, and only refreshed once
+	// Slack rejects it.
+	_, err := api.AuthTest()
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), refreshes.Load())
+
+	// The token is revoked, as in TestTokenRotationRetriesOnTokenExpired.
+	refreshes.Store(1)
+	_, err = api.AuthTest()
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), refreshes.Load())
+	require.Len(t, persisted, 1)
+	assert.True(t, persisted[0].ExpiresAt.IsZero(), "a token refreshed without expires_in does not expire")
+
+	for range 3 {
+		_, err = api.AuthTest()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), refreshes.Load())
+}
+
+func TestTokenRotationConcurrentRefresh(t *testing.T) {
+	server, refreshes := newTokenRotationServer(t, 43200)
+
+	api := New(accessTokenN(0),
+		OptionAPIURL(server.URL+"/"),
+		OptionTokenRotation(TokenRotationConfig{
+			ClientID:     "client-id",
+			RefreshToken: refreshTokenN(0),
+			ExpiresAt:    time.Now().Add(time.Minute),
+		}),
+	)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := api.AuthTest()
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), refreshes.Load())
+}
+
+func TestTokenRotationRetriesOnTokenExpired(t *testing.T) {
+	server, refreshes := newTokenRotationServer(t, 43200)
+
+	// The token is revoked early: Slack rejects it although it has not
+	// reached its expiry time yet.
+	refreshes.Store(1)
+	api := New(accessTokenN(0),
+		OptionAPIURL(server.URL+"/"),
+		OptionTokenRotation(TokenRotationConfig{
+			ClientID:     "client-id",
+			RefreshToken: refreshTokenN(1),
+			ExpiresAt:    time.Now().Add(time.Hour),
+		}),
+	)
+
+	resp, err := api.AuthTest()
+	require.NoError(t, err)
+	assert.Equal(t, "T1", resp.TeamID)
+	assert.Equal(t, int32(2), refreshes.Load())
+}
+
+func TestTokenRotationRetriesOnTokenExpiredOnEveryPath(t *testing.T) {
+	image := filepath.Join(t.TempDir(), "photo.png")
+	require.NoError(t, os.WriteFile(image, []byte("png"), 0o600))
+
+	calls := map[string]func(api *Client) error{
+		"audit logs": func(api *Client) error {
+			_, _, err := api.GetAuditLogs(AuditLogParameters{})
+			return err
+		},
+		"user photo": func(api *Client) error {
+			return api.SetUserPhoto(image, UserSetPhotoParams{})
+		},
+		"remote file": func(api *Client) error {
+			_, err := api.AddRemoteFile(RemoteFileParameters{
+				ExternalID:         "ext-1",
+				ExternalURL:        "https://example.com/1",
+				Title:              "one",
+				PreviewImageReader: strings.NewReader("png"),
+			})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			server, refreshes := newTokenRotationServer(t, 43200)
+			// The token is revoked early, as in TestTokenRotationRetriesOnTokenExpired.
+			refreshes.Store(1)
+			api := New(accessTokenN(0),
+				OptionAPIURL(server.URL+"/"),
+				OptionAuditAPIURL(server.URL+"/"),
+				OptionTokenRotation(TokenRotationConfig{
+					ClientID:     "client-id",
+					RefreshToken: refreshTokenN(1),
+					ExpiresAt:    time.Now().Add(time.Hour),
+				}),
+			)
+
+			require.NoError(t, call(api))
+			assert.Equal(t, int32(2), refreshes.Load())
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TokenType is the kind of a Slack token, as told by its prefix.
+type TokenType string
+
+const (
+	// TokenTypeUnknown is reported for empty tokens and tokens with an
+	// unrecognised prefix. Such tokens are never rejected by the client.
+	TokenTypeUnknown TokenType = ""
+	// TokenTypeBot is a bot token (xoxb, or xoxe.xoxb when rotating).
+	TokenTypeBot TokenType = "bot"
+	// TokenTypeUser is a user token (xoxp).
+	TokenTypeUser TokenType = "user"
+	// TokenTypeApp is an app-level token (xapp), used for Socket Mode.
+	TokenTypeApp TokenType = "app"
+	// TokenTypeConfig is an app configuration token (xoxe.xoxp). Rotating
+	// user tokens share its prefix, so DetectTokenType reports them as config
+	// tokens too, and config tokens are also accepted where a user token is
+	// required.
+	TokenTypeConfig TokenType = "config"
+	// TokenTypeRefresh is a refresh token (xoxe) for token rotation.
+	TokenTypeRefresh TokenType = "refresh"
+	// TokenTypeWorkflow is a token issued to a workflow function run (xwfp).
+	TokenTypeWorkflow TokenType = "workflow"
+)
+
+// DetectTokenType returns the type of token based on its prefix. The prefix
+// of rotating user tokens (xoxe.xoxp) cannot be told apart from that of app
+// configuration tokens, so TokenTypeConfig is returned for both; use
+// Client.TokenType for the token of a client with token rotation.
+func DetectTokenType(token string) TokenType {
+	switch {
+	case strings.HasPrefix(token, "xoxb-"), strings.HasPrefix(token, "xoxe.xoxb-"):
+		return TokenTypeBot
+	case strings.HasPrefix(token, "xoxp-"):
+		return TokenTypeUser
+	case strings.HasPrefix(token, "xapp-"):
+		return TokenTypeApp
+	case strings.HasPrefix(token, "xoxe.xoxp-"):
+		return TokenTypeConfig
+	case strings.HasPrefix(token, "xoxe-"):
+		return TokenTypeRefresh
+	case strings.HasPrefix(token, "xwfp-"):
+		return TokenTypeWorkflow
+	default:
+		return TokenTypeUnknown
+	}
+}
+
+// TokenType returns the type of the client token. With OptionTokenRotation,
+// xoxe.xoxp tokens are reported as user tokens, since app configuration
+// tokens are not rotated that way.
+func (api *Client) TokenType() TokenType {
+	tokenType := DetectTokenType(api.token)
+	if tokenType == TokenTypeConfig && api.tokenRotator != nil {
+		return TokenTypeUser
+	}
+	return tokenType
+}
+
+// TokenTypeError is returned, before any request is sent, when a method is
+// called with a token of a type that Slack would reject with
+// not_allowed_token_type, or without a token when one of a specific type is
+// required.
+type TokenTypeError struct {
+	Method string
+	Want   TokenType
+	Got    TokenType
+	// Missing is set when the required token was not configured.
+	Missing bool
+}
+
+func (e TokenTypeError) Error() string {
+	if e.Missing {
+		return fmt.Sprintf("%s requires %s token, but none is configured", e.Method, withArticle(e.Want))
+	}
+	return fmt.Sprintf("%s requires %s token, got %s token", e.Method, withArticle(e.Want), withArticle(e.Got))
+}
+
+// Is reports whether target is ErrNotAllowedTokenType, the error Slack would
+// have returned.
+func (e TokenTypeError) Is(target error) bool {
+	return target == ErrNotAllowedTokenType
+}
+
+func withArticle(t TokenType) string {
+	if t == TokenTypeApp {
+		return "an app-level"
+	}
+	return "a " + string(t)
+}
+
+// requiredTokenType returns the token type a method only works with, or
+// TokenTypeUnknown when it accepts several.
+func requiredTokenType(method string) TokenType {
+	switch {
+	case method == "apps.connections.open", method == "apps.event.authorizations.list":
+		return TokenTypeApp
+	case strings.HasPrefix(method, "apps.manifest."):
+		return TokenTypeConfig
+	case strings.HasPrefix(method, "admin."):
+		return TokenTypeUser
+	default:
+		return TokenTypeUnknown
+	}
+}
+
+// checkTokenType fails fast when token cannot be used to call method.
+// Tokens of unknown type are let through and left for Slack to judge.
+func checkTokenType(method, token string) error {
+	want := requiredTokenType(method)
+	if want == TokenTypeUnknown {
+		return nil
+	}
+	if token == "" {
+		return TokenTypeError{Method: method, Want: want, Missing: true}
+	}
+
+	got := DetectTokenType(token)
+	switch {
+	case got == TokenTypeUnknown, got == want:
+		return nil
+	case want == TokenTypeUser && got == TokenTypeConfig:
+		return nil
+	}
+	return TokenTypeError{Method: method, Want: want, Got: got}
+}
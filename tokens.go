@@ -12,6 +12,7 @@ func (api *Client) RotateTokens(configToken string, refreshToken string) (*Token
 }
 
 // RotateTokensContext exchanges a refresh token for a new app configuration token with a custom context.
+// The method needs no token, so configToken may be empty.
 // Slack API docs: https://api.slack.com/methods/tooling.tokens.rotate
 func (api *Client) RotateTokensContext(ctx context.Context, configToken string, refreshToken string) (*TokenResponse, error) {
 	if configToken == "" {
@@ -27,7 +28,7 @@ func (api *Client) RotateTokensContext(ctx context.Context, configToken string,
 	}
 
 	response := &TokenResponse{}
-	err := api.getMethod(ctx, "tooling.tokens.rotate", configToken, values, response)
+	err := api.getMethodWithExplicitToken(ctx, "tooling.tokens.rotate", configToken, values, response)
 	if err != nil {
 		return nil, err
 	}
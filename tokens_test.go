@@ -3,6 +3,7 @@ package slack
 import (
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 )
@@ -25,6 +26,32 @@ func TestRotateTokens(t *testing.T) {
 	}
 }
 
+func TestRotateTokensWithOnlyRefreshToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tooling.tokens.rotate" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("unexpected Authorization header %q", auth)
+		}
+		if got := r.URL.Query().Get("refresh_token"); got != "old-refresh" {
+			t.Errorf("refresh_token = %q, want old-refresh", got)
+		}
+		handleRotateToken(rw, r)
+	}))
+	defer server.Close()
+
+	api := New("", OptionAPIURL(server.URL+"/"))
+
+	tok, err := api.RotateTokens("", "old-refresh")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(getTestTokenResponse(), *tok) {
+		t.Fatal(ErrIncorrectResponse)
+	}
+}
+
 func getTestTokenResponse() TokenResponse {
 	return TokenResponse{
 		Token:         "token",
@@ -3,6 +3,7 @@ package slack
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -537,7 +538,9 @@ func (api *Client) SetUserPhotoContext(ctx context.Context, image string, params
 		values.Add("crop_w", strconv.Itoa(params.CropW))
 	}
 
-	err = postLocalWithMultipartResponse(ctx, api.httpclient, api.endpoint+"users.setPhoto", image, "image", api.token, values, response, api)
+	err = api.doWithToken(ctx, "users.setPhoto", values, response, func(ctx context.Context, token string) (http.Header, error) {
+		return nil, postLocalWithMultipartResponse(ctx, api.httpclient, api.endpoint+"users.setPhoto", image, "image", token, values, response, api)
+	})
 	if err != nil {
 		return err
 	}
@@ -697,12 +700,11 @@ func (api *Client) SetUserCustomFieldsContext(ctx context.Context, userID string
 	}
 
 	response := &userResponseFull{}
-	if _, err := postForm(ctx, api.httpclient, APIURL+"users.profile.set", values, response, api); err != nil {
+	if err := api.postMethod(ctx, "users.profile.set", values, response); err != nil {
 		return err
 	}
 
 	return response.Err()
-
 }
 
 // SetUserCustomStatus will set a custom status and emoji for the currently authenticated user.
@@ -10,6 +10,7 @@ import (
 	"image/png"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
 	"strconv"
@@ -696,6 +697,36 @@ func TestSetFieldsMap(t *testing.T) {
 	}
 }
 
+func TestSetUserCustomFieldsWithToken(t *testing.T) {
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+		requests = append(requests, r)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+	api := New("xoxp-default", OptionAPIURL(server.URL+"/"))
+
+	err := api.SetUserCustomFieldsContext(WithToken(context.Background(), "xoxp-other"), "U1", map[string]UserProfileCustomField{
+		"Xf1": {Value: "engineering"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 request to the API URL, got %d", len(requests))
+	}
+	if got := requests[0].URL.Path; got != "/users.profile.set" {
+		t.Errorf("Unexpected path %s", got)
+	}
+	if got := requests[0].PostForm.Get("token"); got != "xoxp-other" {
+		t.Errorf("Expected the token set with WithToken, got %s", got)
+	}
+}
+
 func TestUserProfileCustomFieldsUnmarshalJSON(t *testing.T) {
 	fields := &UserProfileCustomFields{}
 	if err := json.Unmarshal([]byte(`[]`), fields); err != nil {
@@ -244,7 +244,7 @@ func (api *Client) OpenViewContext(
 		return nil, err
 	}
 	resp := &ViewResponse{}
-	err = api.postJSONMethod(ctx, "views.open", api.token, encoded, resp)
+	err = api.postJSONMethod(ctx, "views.open", encoded, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -275,7 +275,7 @@ func (api *Client) PublishViewContext(
 		return nil, err
 	}
 	resp := &ViewResponse{}
-	err = api.postJSONMethod(ctx, "views.publish", api.token, encoded, resp)
+	err = api.postJSONMethod(ctx, "views.publish", encoded, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +307,7 @@ func (api *Client) PushViewContext(
 		return nil, err
 	}
 	resp := &ViewResponse{}
-	err = api.postJSONMethod(ctx, "views.push", api.token, encoded, resp)
+	err = api.postJSONMethod(ctx, "views.push", encoded, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -342,7 +342,7 @@ func (api *Client) UpdateViewContext(
 		return nil, err
 	}
 	resp := &ViewResponse{}
-	err = api.postJSONMethod(ctx, "views.update", api.token, encoded, resp)
+	err = api.postJSONMethod(ctx, "views.update", encoded, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -40,6 +40,16 @@ func PostWebhookCustomHTTP(url string, httpClient *http.Client, msg *WebhookMess
 }
 
 func PostWebhookCustomHTTPContext(ctx context.Context, url string, httpClient *http.Client, msg *WebhookMessage) error {
+	return postWebhook(ctx, url, httpClient, msg)
+}
+
+// PostWebhookContext posts msg to a webhook or response_url with the HTTP
+// client of api, so that OptionHTTPClient and retries apply.
+func (api *Client) PostWebhookContext(ctx context.Context, url string, msg *WebhookMessage) error {
+	return postWebhook(ctx, url, api.httpclient, msg)
+}
+
+func postWebhook(ctx context.Context, url string, client httpClient, msg *WebhookMessage) error {
 	raw, err := json.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("marshal failed: %w", err)
@@ -51,7 +61,7 @@ func PostWebhookCustomHTTPContext(ctx context.Context, url string, httpClient *h
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := httpClient.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to post webhook: %w", err)
 	}
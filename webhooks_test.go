@@ -1,11 +1,15 @@
 package slack
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -86,6 +90,27 @@ func TestPostWebhook_MessageLimitExceeded(t *testing.T) {
 	assert.IsType(t, StatusCodeError{}, err)
 }
 
+func TestClientPostWebhook_Retried(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		rw.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	cfg := RetryConfig{MaxRetries: 1, BackoffInitial: time.Millisecond, BackoffMax: time.Millisecond}
+	cfg.Handlers = []RetryHandler{NewServerErrorRetryHandler(cfg)}
+	api := New("xoxb-test", OptionRetryConfig(cfg))
+
+	err := api.PostWebhookContext(context.Background(), server.URL+"/commands/T1/1/abc", &WebhookMessage{Text: "hi"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load(), "the response URL is posted with the client's retries")
+}
+
 func TestWebhookMessage_UnfurlFields(t *testing.T) {
 	t.Run("nil omits fields", func(t *testing.T) {
 		msg := WebhookMessage{Text: "hello"}
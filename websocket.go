@@ -52,6 +52,16 @@ type RTM struct {
 
 	// connParams is a map of flags for connection parameters.
 	connParams url.Values
+
+	observer ConnectionObserver
+	// sentAt holds when outgoing messages were sent, by ID, until they are
+	// acknowledged. It is only used from handleEvents.
+	sentAt map[int]sentMessage
+}
+
+type sentMessage struct {
+	typ string
+	at  time.Time
 }
 
 // signal that we are disconnected by closing the channel.
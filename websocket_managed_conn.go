@@ -2,8 +2,10 @@ package slack
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	stdurl "net/url"
 	"reflect"
@@ -66,7 +68,8 @@ func (rtm *RTM) ManageConnection() {
 		// the returned err is already passed onto the IncomingEvents channel
 		if info, conn, err = rtm.connect(connectionCount, rtm.useRTMStart); err != nil {
 			// when the connection is unsuccessful its fatal, and we need to bail out.
-			rtm.Debugf("Failed to connect with RTM on try %d: %s", connectionCount, err)
+			rtm.logAt(slog.LevelError, []slog.Attr{slog.Int("connection_count", connectionCount), slog.String("error", err.Error())},
+				"Failed to connect with RTM on try %d: %s", connectionCount, err)
 			rtm.disconnect()
 			return
 		}
@@ -83,7 +86,8 @@ func (rtm *RTM) ManageConnection() {
 			Info:            info,
 		}}
 
-		rtm.Debugf("RTM connection succeeded on try %d", connectionCount)
+		rtm.logAt(slog.LevelInfo, []slog.Attr{slog.Int("connection_count", connectionCount)},
+			"RTM connection succeeded on try %d", connectionCount)
 
 		rawEvents := make(chan json.RawMessage)
 		// we're now connected so we can set up listeners
@@ -129,14 +133,17 @@ func (rtm *RTM) connect(connectionCount int, useRTMStart bool) (*Info, *websocke
 			backoff time.Duration
 		)
 
+		attempt := boff.Attempts() + 1
+
 		// send connecting event
 		rtm.IncomingEvents <- RTMEvent{"connecting", &ConnectingEvent{
-			Attempt:         boff.Attempts() + 1,
+			Attempt:         attempt,
 			ConnectionCount: connectionCount,
 		}}
 
 		// attempt to start the connection
 		info, conn, err := rtm.startRTMAndDial(useRTMStart)
+		rtm.observer.ConnectAttempt(ConnectionTypeRTM, attempt, err)
 		if err == nil {
 			return info, conn, nil
 		}
@@ -144,7 +151,7 @@ func (rtm *RTM) connect(connectionCount int, useRTMStart bool) (*Info, *websocke
 		// check for fatal errors
 		switch err.Error() {
 		case errInvalidAuth, errInactiveAccount, errMissingAuthToken, errTokenRevoked:
-			rtm.Debugf("invalid auth when connecting with RTM: %s", err)
+			rtm.logAt(slog.LevelError, []slog.Attr{slog.String("error", err.Error())}, "invalid auth when connecting with RTM: %s", err)
 			rtm.IncomingEvents <- RTMEvent{"invalid_auth", &InvalidAuthEvent{}}
 			return nil, nil, err
 		default:
@@ -172,7 +179,12 @@ func (rtm *RTM) connect(connectionCount int, useRTMStart bool) (*Info, *websocke
 		}}
 
 		// get time we should wait before attempting to connect again
-		rtm.Debugf("reconnection %d failed: %s reconnecting in %v\n", boff.Attempts(), err, backoff)
+		rtm.logAt(slog.LevelWarn, []slog.Attr{
+			slog.Int("connection_count", connectionCount),
+			slog.Int("attempt", boff.Attempts()),
+			slog.Duration("backoff", backoff),
+			slog.String("error", err.Error()),
+		}, "reconnection %d failed: %s reconnecting in %v", boff.Attempts(), err, backoff)
 
 		// wait for one of the following to occur,
 		// backoff duration has elapsed, killChannel is signalled, or
@@ -240,7 +252,11 @@ func (rtm *RTM) startRTMAndDial(useRTMStart bool) (info *Info, _ *websocket.Conn
 // This should not be called directly! Instead a boolean value (true for
 // intentional, false otherwise) should be sent to the killChannel on the RTM.
 func (rtm *RTM) killConnection(intentional bool, cause error) (err error) {
-	rtm.Debugln("killing connection", cause)
+	level := slog.LevelWarn
+	if intentional {
+		level = slog.LevelInfo
+	}
+	rtm.logAt(level, []slog.Attr{slog.Bool("intentional", intentional), slog.Any("cause", cause)}, "killing connection %v", cause)
 
 	if rtm.conn != nil {
 		err = rtm.conn.Close()
@@ -250,6 +266,8 @@ func (rtm *RTM) killConnection(intentional bool, cause error) (err error) {
 
 	if intentional {
 		rtm.disconnect()
+	} else {
+		rtm.observer.Reconnecting(ConnectionTypeRTM, reconnectReason(cause))
 	}
 
 	return err
@@ -261,6 +279,9 @@ func (rtm *RTM) killConnection(intentional bool, cause error) (err error) {
 // outgoingMessages channel. This also handles incoming raw events from the RTM
 // rawEvents channel.
 func (rtm *RTM) handleEvents(events chan json.RawMessage) {
+	// messages sent on a previous connection will not be acknowledged
+	rtm.sentAt = make(map[int]sentMessage)
+
 	ticker := time.NewTicker(rtm.pingInterval)
 	defer ticker.Stop()
 	for {
@@ -340,6 +361,7 @@ func (rtm *RTM) sendWithDeadline(msg any) error {
 func (rtm *RTM) sendOutgoingMessage(msg OutgoingMessage) {
 	rtm.Debugln("Sending message:", msg)
 	if len([]rune(msg.Text)) > MaxMessageTextLength {
+		rtm.observer.ResponseDropped(ConnectionTypeRTM, DropReasonOversized)
 		rtm.IncomingEvents <- RTMEvent{"outgoing_error", &MessageTooLongEvent{
 			Message:   msg,
 			MaxLength: MaxMessageTextLength,
@@ -348,10 +370,29 @@ func (rtm *RTM) sendOutgoingMessage(msg OutgoingMessage) {
 	}
 
 	if err := rtm.sendWithDeadline(msg); err != nil {
+		rtm.observer.ResponseDropped(ConnectionTypeRTM, DropReasonWriteFailed)
 		rtm.IncomingEvents <- RTMEvent{"outgoing_error", &OutgoingErrorEvent{
 			Message:  msg,
 			ErrorObj: err,
 		}}
+		return
+	}
+
+	if rtm.sentAt != nil {
+		rtm.sentAt[msg.ID] = sentMessage{typ: msg.Type, at: time.Now()}
+	}
+}
+
+// reconnectReason maps the cause of an unintentional disconnection to a
+// ConnectionObserver reconnect reason.
+func reconnectReason(cause error) string {
+	switch {
+	case errors.Is(cause, ErrRTMGoodbye):
+		return ReconnectReasonGoodbye
+	case errors.Is(cause, ErrRTMDeadman):
+		return ReconnectReasonPingTimeout
+	default:
+		return ReconnectReasonConnectionError
 	}
 }
 
@@ -430,6 +471,8 @@ func (rtm *RTM) handleRawEvent(rawEvent json.RawMessage) string {
 		return ""
 	}
 
+	rtm.observer.EventReceived(ConnectionTypeRTM, event.Type)
+
 	switch event.Type {
 	case rtmEventTypeAck:
 		rtm.handleAck(rawEvent)
@@ -455,6 +498,11 @@ func (rtm *RTM) handleAck(event json.RawMessage) {
 		return
 	}
 
+	if sent, ok := rtm.sentAt[ack.ReplyTo]; ok {
+		delete(rtm.sentAt, ack.ReplyTo)
+		rtm.observer.Acked(ConnectionTypeRTM, sent.typ, time.Since(sent.at))
+	}
+
 	switch {
 	case ack.Ok:
 		rtm.IncomingEvents <- RTMEvent{"ack", ack}
@@ -487,6 +535,7 @@ func (rtm *RTM) handlePong(event json.RawMessage) {
 	}
 
 	latency := time.Since(time.Unix(p.Timestamp, 0))
+	rtm.observer.PingLatency(ConnectionTypeRTM, latency)
 	rtm.IncomingEvents <- RTMEvent{"latency_report", &LatencyReport{Value: latency}}
 }
 
@@ -499,7 +548,7 @@ func (rtm *RTM) handlePong(event json.RawMessage) {
 func (rtm *RTM) handleEvent(typeStr string, event json.RawMessage) {
 	v, exists := EventMapping[typeStr]
 	if !exists {
-		rtm.Debugf("RTM Error - received unmapped event %q: %s\n", typeStr, string(event))
+		rtm.logAt(slog.LevelWarn, []slog.Attr{slog.String("event_type", typeStr)}, "RTM Error - received unmapped event %q: %s", typeStr, string(event))
 		err := NewUnmappedError("RTM Error", typeStr, event)
 		rtm.IncomingEvents <- RTMEvent{"unmarshalling_error", &UnmarshallingErrorEvent{err}}
 		return
@@ -508,7 +557,7 @@ func (rtm *RTM) handleEvent(typeStr string, event json.RawMessage) {
 	recvEvent := reflect.New(t).Interface()
 	err := json.Unmarshal(event, recvEvent)
 	if err != nil {
-		rtm.Debugf("RTM Error, could not unmarshall event %q: %s\n", typeStr, string(event))
+		rtm.logAt(slog.LevelWarn, []slog.Attr{slog.String("event_type", typeStr)}, "RTM Error, could not unmarshall event %q: %s", typeStr, string(event))
 		err := fmt.Errorf("RTM Error: Could not unmarshall event %q", typeStr)
 		rtm.IncomingEvents <- RTMEvent{"unmarshalling_error", &UnmarshallingErrorEvent{err}}
 		return